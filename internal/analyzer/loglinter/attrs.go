@@ -0,0 +1,285 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/types"
+	"strconv"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const zapcorePath = "go.uber.org/zap/zapcore"
+
+// attr — атрибут записи лога: выражение ключа и, если есть, значения.
+type attr struct {
+	key   ast.Expr
+	value ast.Expr
+	// valueType — тип значения, если оно не аргумент вызова (элемент logrus.Fields).
+	valueType types.Type
+}
+
+// withMethods — методы, все аргументы которых — пары ключ/значение (logger.With(...)).
+var withMethods = map[loggerType]string{
+	{slogPath, "Logger"}:       "slog",
+	{zapPath, "SugaredLogger"}: "zap-sugar",
+}
+
+// callAttrs находит атрибуты, которые задаёт вызов: конструкторы slog.String/Int/Any/Group,
+// поля zap.String/Int/Any/..., методы полей zerolog (.Str("key", ...)), WithField и литерал
+// logrus.Fields в WithFields, пары ключ/значение в вызовах логгеров и в With. Возвращает вид
+// логгера для диагностик.
+func callAttrs(pass *analysis.Pass, d *detector, call *ast.CallExpr) ([]attr, string) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return nil, ""
+	}
+
+	var (
+		attrs []attr
+		kind  string
+	)
+	if k, ok := attrConstructorKind(fn); ok {
+		key, ok := fieldKeyArg(pass, call)
+		if !ok {
+			return nil, ""
+		}
+		a := attr{key: key}
+		if len(call.Args) > 1 {
+			a.value = call.Args[1]
+		}
+		attrs, kind = append(attrs, a), k
+	}
+	if args, k, ok := kvArgs(pass, d, call); ok {
+		attrs, kind = append(attrs, kvAttrs(pass, args)...), k
+	}
+	if fn.Name() == "WithFields" && fn.Pkg().Path() == logrusPath && len(call.Args) == 1 {
+		attrs, kind = append(attrs, fieldsAttrs(pass, call.Args[0])...), "logrus"
+	}
+	return attrs, kind
+}
+
+// fieldsAttrs возвращает ключи и значения литерала logrus.Fields{"key": value}.
+func fieldsAttrs(pass *analysis.Pass, expr ast.Expr) []attr {
+	lit, ok := ast.Unparen(expr).(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	m, ok := pass.TypesInfo.TypeOf(lit).Underlying().(*types.Map)
+	if !ok {
+		return nil
+	}
+	var attrs []attr
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			attrs = append(attrs, attr{key: kv.Key, value: kv.Value, valueType: m.Elem()})
+		}
+	}
+	return attrs
+}
+
+// kvArgs возвращает аргументы вызова, которые являются парами ключ/значение: аргументы после
+// сообщения в вызовах логгеров с kv, все аргументы With (метода и функции slog.With) и аргументы
+// slog.Group после имени группы.
+// Вызовы с разворачиванием среза (args...) не разбираются.
+func kvArgs(pass *analysis.Pass, d *detector, call *ast.CallExpr) ([]ast.Expr, string, bool) {
+	if call.Ellipsis.IsValid() {
+		return nil, "", false
+	}
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return nil, "", false
+	}
+
+	if fn.Pkg().Path() == slogPath && fn.Name() == "Group" && len(call.Args) > 0 {
+		return call.Args[1:], "slog", true
+	}
+	if fn.Pkg().Path() == slogPath && fn.Name() == "With" && fn.Type().(*types.Signature).Recv() == nil {
+		return call.Args, "slog", true
+	}
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil && fn.Name() == "With" {
+		if named := derefNamed(recv.Type()); named != nil && named.Obj().Pkg() != nil {
+			if kind, ok := withMethods[loggerType{named.Obj().Pkg().Path(), named.Obj().Name()}]; ok {
+				return call.Args, kind, true
+			}
+		}
+	}
+
+	lc, ok := d.detectLoggerCall(pass, call)
+	if !ok || !lc.kv || lc.msgIdx+1 > len(call.Args) {
+		return nil, "", false
+	}
+	return call.Args[lc.msgIdx+1:], lc.kind, true
+}
+
+// attrConstructorKind распознаёт функции, создающие один атрибут: функции пакетов slog и zap,
+// возвращающие slog.Attr или zap.Field, методы полей *zerolog.Event и WithField logrus.
+func attrConstructorKind(fn *types.Func) (string, bool) {
+	sig := fn.Type().(*types.Signature)
+	if recv := sig.Recv(); recv != nil {
+		switch {
+		case isNamedType(recv.Type(), zerologPath, "Event"):
+			return "zerolog", true
+		case fn.Name() == "WithField" &&
+			(isNamedType(recv.Type(), logrusPath, "Logger") || isNamedType(recv.Type(), logrusPath, "Entry")):
+			return "logrus", true
+		}
+		return "", false
+	}
+	if fn.Pkg().Path() == logrusPath && fn.Name() == "WithField" {
+		return "logrus", true
+	}
+	if sig.Results().Len() != 1 {
+		return "", false
+	}
+	res := sig.Results().At(0).Type()
+	switch {
+	case fn.Pkg().Path() == slogPath && isNamedType(res, slogPath, "Attr"):
+		return "slog", true
+	case fn.Pkg().Path() == zapPath && isNamedType(res, zapcorePath, "Field"):
+		return "zap", true
+	}
+	return "", false
+}
+
+// isAttrValue сообщает, что t — готовый атрибут (slog.Attr или zap.Field), а не ключ.
+func isAttrValue(t types.Type) bool {
+	return isNamedType(t, slogPath, "Attr") || isNamedType(t, zapcorePath, "Field")
+}
+
+// kvAttrs разбирает чередующиеся пары ключ/значение так же, как checkKeyValues: готовые
+// атрибуты занимают одну позицию, ключ именованного строкового типа — пару без атрибута,
+// прочие аргументы пропускаются.
+func kvAttrs(pass *analysis.Pass, args []ast.Expr) []attr {
+	var attrs []attr
+	for i := 0; i < len(args); {
+		t := pass.TypesInfo.TypeOf(args[i])
+		switch {
+		case t == nil || isAttrValue(t):
+			i++
+			continue
+		case !isKeyString(t):
+			i++
+			if isStringType(t) {
+				i++
+			}
+			continue
+		}
+		a := attr{key: args[i]}
+		if i+1 < len(args) {
+			a.value = args[i+1]
+		}
+		attrs = append(attrs, a)
+		i += 2
+	}
+	return attrs
+}
+
+// staticKey возвращает текст ключа, если он полностью известен статически.
+func staticKey(pass *analysis.Pass, key ast.Expr) (string, bool) {
+	parts := messageParts(pass, key)
+	if hasDynamicPart(parts) {
+		return "", false
+	}
+	return joinStatic(parts)
+}
+
+// redactedValue — значение, которым исправление заменяет значение атрибута с чувствительным ключом.
+const redactedValue = `"[REDACTED]"`
+
+// checkAttrs проверяет ключи атрибутов, которые задаёт вызов: по списку чувствительных
+// ключей (LOG004) и на соответствие соглашению об именовании (LOG005).
+func (l *linter) checkAttrs(pass *analysis.Pass, call *ast.CallExpr, report func(analysis.Diagnostic)) {
+	checkSensitive := l.cfg.Rules.Sensitive && len(l.cfg.SensitiveKeys) > 0
+	checkStyle := l.cfg.Rules.AttrKeys && l.keyStyle.Re != nil
+	if !checkSensitive && !checkStyle {
+		return
+	}
+
+	attrs, kind := callAttrs(pass, l.detector, call)
+	for _, a := range attrs {
+		key, ok := staticKey(pass, a.key)
+		if !ok {
+			continue
+		}
+		if checkSensitive {
+			if v, ok := rules.SensitiveKey(key, l.cfg.SensitiveKeys); ok {
+				report(l.sensitiveKeyDiagnostic(pass, call, a, v, kind))
+			}
+		}
+		if checkStyle {
+			if v, ok := rules.AttrKeyStyle(key, l.keyStyle); ok {
+				report(l.keyStyleDiagnostic(pass, a, key, v, kind))
+			}
+		}
+	}
+}
+
+// sensitiveKeyDiagnostic строит диагностику LOG004 для ключа; исправление заменяет значение
+// заглушкой, если параметр принимает строку.
+func (l *linter) sensitiveKeyDiagnostic(pass *analysis.Pass, call *ast.CallExpr, a attr, v rules.Violation, kind string) analysis.Diagnostic {
+	diag := analysis.Diagnostic{
+		Pos:     a.key.Pos(),
+		End:     a.key.End(),
+		Message: string(v.ID) + " " + v.Message + " (" + kind + ")",
+	}
+	if a.value == nil {
+		return diag
+	}
+	t := a.valueType
+	if t == nil {
+		t = argParamType(pass, call, a.value)
+	}
+	if t == nil || !types.AssignableTo(types.Typ[types.String], t) {
+		return diag
+	}
+	diag.SuggestedFixes = []analysis.SuggestedFix{{
+		Message:   "replace value with " + redactedValue,
+		TextEdits: []analysis.TextEdit{{Pos: a.value.Pos(), End: a.value.End(), NewText: []byte(redactedValue)}},
+	}}
+	return diag
+}
+
+// keyStyleDiagnostic строит диагностику LOG005 с исправлением, переименовывающим ключ.
+func (l *linter) keyStyleDiagnostic(pass *analysis.Pass, a attr, key string, v rules.Violation, kind string) analysis.Diagnostic {
+	diag := analysis.Diagnostic{
+		Pos:     a.key.Pos(),
+		End:     a.key.End(),
+		Message: string(v.ID) + " " + v.Message + " (" + kind + ")",
+	}
+	if fixed, ok := rules.ConvertKey(key, l.keyStyle.Name); ok && fixed != key {
+		if pos, end, ok := fixTargetForPart(pass, msgPart{text: key, expr: a.key, static: true}); ok {
+			diag.SuggestedFixes = []analysis.SuggestedFix{{
+				Message:   "rename attribute key to " + strconv.Quote(fixed),
+				TextEdits: []analysis.TextEdit{{Pos: pos, End: end, NewText: []byte(strconv.Quote(fixed))}},
+			}}
+		}
+	}
+	return diag
+}
+
+// argParamType возвращает тип параметра, которому передаётся аргумент arg вызова call
+// (для вариативного параметра — тип элемента).
+func argParamType(pass *analysis.Pass, call *ast.CallExpr, arg ast.Expr) types.Type {
+	sig, ok := pass.TypesInfo.TypeOf(call.Fun).Underlying().(*types.Signature)
+	if !ok {
+		return nil
+	}
+	params := sig.Params()
+	for i, a := range call.Args {
+		if a != arg {
+			continue
+		}
+		if sig.Variadic() && i >= params.Len()-1 {
+			if call.Ellipsis.IsValid() {
+				return nil
+			}
+			return params.At(params.Len() - 1).Type().(*types.Slice).Elem()
+		}
+		if i < params.Len() {
+			return params.At(i).Type()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/iconfire7/loglintergo/internal/config"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// defaultDangerousValues — встроенный каталог значений, раскрывающих учётные данные.
+var defaultDangerousValues = []config.DangerousValue{
+	{Package: "net/http", Type: "Request", Reason: "request headers, cookies and form values may contain credentials"},
+	{Package: "net/http", Type: "Header", Reason: "headers may contain Authorization and cookies"},
+	{Package: "net/http", Type: "Cookie", Reason: "cookies carry session tokens"},
+	{Package: "net/url", Type: "URL", Reason: "URL may contain user:password@", Redact: "Redacted"},
+	{Package: "net/url", Type: "URL", Func: "String", Reason: "URL may contain user:password@", Redact: "Redacted"},
+	{Package: "net/url", Type: "Userinfo", Reason: "userinfo contains a password"},
+	{Package: "os", Func: "Environ", Reason: "environment contains secrets"},
+	{Package: "net/http/httputil", Func: "DumpRequest", Reason: "request dump includes headers and body"},
+	{Package: "net/http/httputil", Func: "DumpRequestOut", Reason: "request dump includes headers and body"},
+	{Package: "net/http/httputil", Func: "DumpResponse", Reason: "response dump includes headers and body"},
+	{Package: "github.com/go-sql-driver/mysql", Type: "Config", Func: "FormatDSN", Reason: "DSN contains database credentials"},
+	{Package: "github.com/jackc/pgx/v5/pgconn", Type: "Config", Func: "ConnString", Reason: "connection string contains database credentials"},
+	{Package: "github.com/jackc/pgx/v5", Type: "ConnConfig", Func: "ConnString", Reason: "connection string contains database credentials"},
+}
+
+// dangerousCatalog — каталог опасных типов и функций, подготовленный из настроек.
+type dangerousCatalog struct {
+	types map[loggerType]config.DangerousValue
+	funcs map[funcKey]config.DangerousValue
+}
+
+func newDangerousCatalog(custom []config.DangerousValue) *dangerousCatalog {
+	c := &dangerousCatalog{
+		types: map[loggerType]config.DangerousValue{},
+		funcs: map[funcKey]config.DangerousValue{},
+	}
+	for _, list := range [][]config.DangerousValue{defaultDangerousValues, custom} {
+		for _, v := range list {
+			if v.Func != "" {
+				c.funcs[funcKey{v.Package, v.Type, v.Func}] = v
+			} else if v.Type != "" {
+				c.types[loggerType{v.Package, v.Type}] = v
+			}
+		}
+	}
+	return c
+}
+
+// typeEntry ищет тип значения (с учётом указателей и элементов срезов и массивов) в каталоге.
+func (c *dangerousCatalog) typeEntry(t types.Type) (config.DangerousValue, bool) {
+	for range 4 {
+		switch u := types.Unalias(t).(type) {
+		case *types.Pointer:
+			t = u.Elem()
+			continue
+		case *types.Slice:
+			t = u.Elem()
+			continue
+		case *types.Array:
+			t = u.Elem()
+			continue
+		}
+		break
+	}
+	named := derefNamed(t)
+	if named == nil || named.Obj().Pkg() == nil {
+		return config.DangerousValue{}, false
+	}
+	v, ok := c.types[loggerType{named.Obj().Pkg().Path(), named.Obj().Name()}]
+	return v, ok
+}
+
+// funcEntry ищет вызов из каталога, результат которого попадает в выражение.
+func (c *dangerousCatalog) funcEntry(pass *analysis.Pass, expr ast.Expr, seen map[*types.Var]bool) (config.DangerousValue, *types.Func, bool) {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.CallExpr:
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return c.funcEntry(pass, e.Args[0], seen)
+		}
+		fn, ok := typeutil.Callee(pass.TypesInfo, e).(*types.Func)
+		if !ok || fn.Pkg() == nil {
+			return config.DangerousValue{}, nil, false
+		}
+		v, ok := c.funcs[keyOf(fn)]
+		if sel, isSel := ast.Unparen(e.Fun).(*ast.SelectorExpr); ok && isSel && v.Package == "net/url" && v.Type == "URL" {
+			ok = urlMayHaveUserinfo(pass, sel.X, map[*types.Var]bool{})
+		}
+		return v, fn, ok
+
+	case *ast.Ident:
+		obj, ok := pass.TypesInfo.Uses[e].(*types.Var)
+		if !ok || seen[obj] || obj.Parent() == nil || obj.Parent() == obj.Pkg().Scope() {
+			return config.DangerousValue{}, nil, false
+		}
+		seen[obj] = true
+		body := enclosingFuncBody(pass, e)
+		if body == nil {
+			return config.DangerousValue{}, nil, false
+		}
+		for _, rhs := range definingCalls(pass, body, obj) {
+			if v, fn, ok := c.funcEntry(pass, rhs, seen); ok {
+				return v, fn, true
+			}
+		}
+	}
+	return config.DangerousValue{}, nil, false
+}
+
+// definingCalls возвращает правые части присваиваний переменной v (в том числе dump, err := f()).
+func definingCalls(pass *analysis.Pass, body *ast.BlockStmt, v *types.Var) []ast.Expr {
+	out := varAssignments(pass, body, v)
+	ast.Inspect(body, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok || len(as.Rhs) != 1 || len(as.Lhs) < 2 {
+			return true
+		}
+		for _, lhs := range as.Lhs {
+			id, ok := lhs.(*ast.Ident)
+			if ok && (pass.TypesInfo.Defs[id] == v || pass.TypesInfo.Uses[id] == v) {
+				out = append(out, as.Rhs[0])
+			}
+		}
+		return true
+	})
+	return out
+}
+
+// checkDangerousValues сообщает о логируемых значениях из каталога опасных типов и функций.
+func (l *linter) checkDangerousValues(pass *analysis.Pass, call *ast.CallExpr, report func(analysis.Diagnostic)) {
+	if !l.cfg.Rules.DangerousValues {
+		return
+	}
+	values, kind := loggedValues(pass, l.detector, call)
+	for _, v := range values {
+		t := pass.TypesInfo.TypeOf(v)
+		if t == nil {
+			continue
+		}
+
+		var diag analysis.Diagnostic
+		if entry, ok := l.dangerous.typeEntry(t); ok {
+			if entry.Package == "net/url" && entry.Type == "URL" && !urlMayHaveUserinfo(pass, v, map[*types.Var]bool{}) {
+				continue
+			}
+			diag = analysis.Diagnostic{
+				Pos: v.Pos(),
+				End: v.End(),
+				Message: string(rules.RDangerousValue) + " logged value of type " +
+					types.TypeString(t, types.RelativeTo(pass.Pkg)) + " leaks credentials: " + entry.Reason + " (" + kind + ")",
+			}
+			if entry.Redact != "" && derefNamed(t) != nil && hasMethod(t, entry.Redact) {
+				edits := []analysis.TextEdit{{Pos: v.End(), End: v.End(), NewText: []byte("." + entry.Redact + "()")}}
+				switch ast.Unparen(v).(type) {
+				case *ast.Ident, *ast.SelectorExpr, *ast.CallExpr, *ast.IndexExpr:
+				default:
+					edits = []analysis.TextEdit{
+						{Pos: v.Pos(), End: v.Pos(), NewText: []byte("(")},
+						{Pos: v.End(), End: v.End(), NewText: []byte(")." + entry.Redact + "()")},
+					}
+				}
+				diag.SuggestedFixes = []analysis.SuggestedFix{{Message: "log " + entry.Redact + "() instead", TextEdits: edits}}
+			}
+		} else if entry, fn, ok := l.dangerous.funcEntry(pass, v, map[*types.Var]bool{}); ok {
+			diag = analysis.Diagnostic{
+				Pos: v.Pos(),
+				End: v.End(),
+				Message: string(rules.RDangerousValue) + " logged result of " + funcName(fn) +
+					" leaks credentials: " + entry.Reason + " (" + kind + ")",
+			}
+			if c, ok := ast.Unparen(v).(*ast.CallExpr); ok && entry.Redact != "" {
+				if sel, ok := ast.Unparen(c.Fun).(*ast.SelectorExpr); ok && sel.Sel.Name == fn.Name() && hasMethod(pass.TypesInfo.TypeOf(sel.X), entry.Redact) {
+					diag.SuggestedFixes = []analysis.SuggestedFix{{
+						Message:   "log " + entry.Redact + "() instead",
+						TextEdits: []analysis.TextEdit{{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte(entry.Redact)}},
+					}}
+				}
+			}
+		} else {
+			continue
+		}
+		report(diag)
+	}
+}
+
+// urlMayHaveUserinfo проверяет, что поле User логируемого url.URL может быть заполнено.
+func urlMayHaveUserinfo(pass *analysis.Pass, expr ast.Expr, seen map[*types.Var]bool) bool {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return urlMayHaveUserinfo(pass, e.X, seen)
+		}
+	case *ast.StarExpr:
+		return urlMayHaveUserinfo(pass, e.X, seen)
+
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "User" {
+				return true
+			}
+		}
+		return false
+
+	case *ast.CallExpr:
+		fn, ok := typeutil.Callee(pass.TypesInfo, e).(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "net/url" || len(e.Args) != 1 {
+			return true
+		}
+		if fn.Name() != "Parse" && fn.Name() != "ParseRequestURI" || fn.Signature().Recv() != nil {
+			return true
+		}
+		tv := pass.TypesInfo.Types[e.Args[0]]
+		if tv.Value == nil || tv.Value.Kind() != constant.String {
+			return true
+		}
+		return strings.Contains(constant.StringVal(tv.Value), "@")
+
+	case *ast.Ident:
+		obj, ok := pass.TypesInfo.Uses[e].(*types.Var)
+		if !ok || obj.Parent() == nil || obj.Parent() == obj.Pkg().Scope() {
+			return true
+		}
+		if seen[obj] {
+			return false
+		}
+		seen[obj] = true
+		body := enclosingFuncBody(pass, e)
+		if body == nil {
+			return true
+		}
+		assigns := definingCalls(pass, body, obj)
+		if len(assigns) == 0 || userAssigned(pass, body, obj) {
+			return true
+		}
+		for _, rhs := range assigns {
+			if urlMayHaveUserinfo(pass, rhs, seen) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// userAssigned сообщает, что в body полю User переменной v присваивается значение (u.User = ...).
+func userAssigned(pass *analysis.Pass, body *ast.BlockStmt, v *types.Var) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		as, ok := n.(*ast.AssignStmt)
+		if !ok {
+			return !found
+		}
+		for _, lhs := range as.Lhs {
+			sel, ok := ast.Unparen(lhs).(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "User" {
+				continue
+			}
+			if id, ok := ast.Unparen(sel.X).(*ast.Ident); ok && pass.TypesInfo.Uses[id] == v {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// hasMethod сообщает, что у значения типа t есть метод name.
+func hasMethod(t types.Type, name string) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, true, nil, name)
+	_, ok := obj.(*types.Func)
+	return ok
+}
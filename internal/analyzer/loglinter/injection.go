@@ -0,0 +1,108 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ssa"
+)
+
+// checkInjection сообщает о динамических частях сообщения, в которые попадают недоверенные
+// данные без экранирования: перевод строки в значении позволяет подделать запись лога.
+// Возвращает, предложена ли правка, переносящая значения в атрибуты.
+func (l *linter) checkInjection(pass *analysis.Pass, info *buildssa.SSA, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr, report func(analysis.Diagnostic)) bool {
+	src, at, ok := l.injectionSource(pass, info, call, lc, msgExpr)
+	if !ok {
+		return false
+	}
+	diag := analysis.Diagnostic{
+		Pos: at.Pos(),
+		End: at.End(),
+		Message: string(rules.RInjection) + " untrusted data from " + funcName(src.fn) +
+			" is written into log message without escaping (" + lc.kind + ")",
+		Related: src.related(),
+	}
+	fixed := false
+	if fix, ok := l.structuredFix(pass, call, lc, msgExpr); ok {
+		diag.SuggestedFixes, fixed = []analysis.SuggestedFix{fix}, true
+	}
+	report(diag)
+	return fixed
+}
+
+// injectionSource находит источник недоверенных данных в динамической части сообщения и
+// операнд сообщения, через который они попали в текст.
+func (l *linter) injectionSource(pass *analysis.Pass, info *buildssa.SSA, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr) (taintSource, ast.Expr, bool) {
+	parts := messageParts(pass, msgExpr)
+	if !hasDynamicPart(parts) {
+		return taintSource{}, nil, false
+	}
+	args := ssaCallArgs(info, call)
+	if lc.msgIdx >= len(args) || args[lc.msgIdx] == nil {
+		return taintSource{}, nil, false
+	}
+	src, ok := l.taint.source(args[lc.msgIdx], l.taint.escapers, map[ssa.Value]bool{})
+	if !ok {
+		return taintSource{}, nil, false
+	}
+	at, src := l.taintedOperand(msgExpr, args[lc.msgIdx], parts, src)
+	return src, at, true
+}
+
+// taintedOperand возвращает операнд сообщения с недоверенным значением и путь к нему от
+// источника. Для конкатенации операнд находится по SSA: каждой операции + соответствует BinOp
+// с той же позицией, проверяется правый операнд, затем левый. Иначе
+// выбирается динамическая часть, внутри которой лежит шаг пути от источника, или
+// единственная динамическая часть; если выбрать нельзя — сообщение целиком.
+func (l *linter) taintedOperand(msgExpr ast.Expr, v ssa.Value, parts []msgPart, src taintSource) (ast.Expr, taintSource) {
+	expr := ast.Unparen(msgExpr)
+	for {
+		switch x := v.(type) {
+		case *ssa.MakeInterface:
+			v = x.X
+			continue
+		case *ssa.ChangeType:
+			v = x.X
+			continue
+		case *ssa.Convert:
+			v = x.X
+			continue
+		}
+		bin, ok := v.(*ssa.BinOp)
+		if !ok || bin.Op != token.ADD {
+			break
+		}
+		be, ok := expr.(*ast.BinaryExpr)
+		if !ok || be.OpPos != bin.Pos() {
+			break
+		}
+		if ysrc, tainted := l.taint.source(bin.Y, l.taint.escapers, map[ssa.Value]bool{}); tainted {
+			return be.Y, ysrc
+		}
+		v, expr = bin.X, ast.Unparen(be.X)
+	}
+	if expr != ast.Unparen(msgExpr) {
+		// Правые операнды чистые: значение несёт самый левый операнд конкатенации.
+		return expr, src
+	}
+
+	var dynamic []ast.Expr
+	for _, p := range parts {
+		if p.static || p.expr == nil {
+			continue
+		}
+		for _, s := range src.steps {
+			if p.expr.Pos() <= s.pos && s.pos < p.expr.End() {
+				return p.expr, src
+			}
+		}
+		dynamic = append(dynamic, p.expr)
+	}
+	if len(dynamic) == 1 {
+		return dynamic[0], src
+	}
+	return msgExpr, src
+}
@@ -0,0 +1,64 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// interpolation описывает, как значения подставлены в сообщение: "fmt.Sprintf", "string concatenation" или "".
+func interpolation(pass *analysis.Pass, msgExpr ast.Expr) string {
+	switch e := ast.Unparen(msgExpr).(type) {
+	case *ast.BinaryExpr:
+		if t := pass.TypesInfo.TypeOf(e); e.Op == token.ADD && t != nil && isStringType(t) {
+			return "string concatenation"
+		}
+	case *ast.CallExpr:
+		fn, ok := typeutil.Callee(pass.TypesInfo, e).(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+			return ""
+		}
+		sf, ok := lookupStringFunc(pass, e)
+		if !ok {
+			return ""
+		}
+		if sf.kind == sfFormat && (len(e.Args) == 0 || pass.TypesInfo.Types[e.Args[0]].Value == nil) {
+			// Шаблон не константа (обёртка Infof(format, args...)): статического текста,
+			// который остался бы сообщением, нет.
+			return ""
+		}
+		return "fmt." + fn.Name()
+	}
+	return ""
+}
+
+// checkInterpolation сообщает о значениях, подставленных в сообщение структурированного логгера.
+// offerFix — предлагать ли правку, переписывающую сообщение; возвращает, предложена ли она.
+func (l *linter) checkInterpolation(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr, offerFix bool, report func(analysis.Diagnostic)) bool {
+	// Значения некуда перенести, если вызов не принимает атрибуты: так выглядят обёртки
+	// вроде func Plain(msg string) { slog.Info(msg) } и логгеры из loggers.
+	if !l.cfg.InterpolationKinds[lc.kind] || !lc.kv && !lc.fields {
+		return false
+	}
+	how := interpolation(pass, msgExpr)
+	if how == "" || !hasDynamicPart(messageParts(pass, msgExpr)) {
+		return false
+	}
+
+	diag := analysis.Diagnostic{
+		Pos: msgExpr.Pos(),
+		End: msgExpr.End(),
+		Message: string(rules.RInterpolation) + " log message is built with " + how +
+			"; pass values as structured attributes (" + lc.kind + ")",
+	}
+	fixed := false
+	if fix, ok := l.structuredFix(pass, call, lc, msgExpr); ok && offerFix {
+		diag.SuggestedFixes, fixed = []analysis.SuggestedFix{fix}, true
+	}
+	report(diag)
+	return fixed
+}
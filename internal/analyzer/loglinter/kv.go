@@ -0,0 +1,111 @@
+package loglinter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkKeyValues проверяет списки пар ключ/значение (slog, *w-методы zap sugar, With, slog.Group):
+// ключ без значения, ключ не строкового типа, смешение готовых атрибутов с парами и повторные ключи.
+// Такие аргументы во время выполнения превращаются в !BADKEY или теряются.
+func (l *linter) checkKeyValues(pass *analysis.Pass, call *ast.CallExpr, report func(analysis.Diagnostic)) {
+	if !l.cfg.Rules.KeyValues {
+		return
+	}
+	args, kind, ok := kvArgs(pass, l.detector, call)
+	if !ok {
+		return
+	}
+
+	diag := func(n ast.Node, msg string) {
+		report(analysis.Diagnostic{
+			Pos:     n.Pos(),
+			End:     n.End(),
+			Message: string(rules.RKeyValues) + " " + msg + " (" + kind + ")",
+		})
+	}
+	seen := map[string]bool{}
+	checkDuplicate := func(key ast.Expr) {
+		text, ok := staticKey(pass, key)
+		if !ok {
+			return
+		}
+		if seen[text] {
+			diag(key, fmt.Sprintf("duplicate key %q", text))
+		}
+		seen[text] = true
+	}
+
+	var firstAttr, firstKey ast.Expr
+	for i := 0; i < len(args); {
+		arg := args[i]
+		t := pass.TypesInfo.TypeOf(arg)
+		switch {
+		case t == nil:
+			return
+		case isAttrValue(t):
+			if firstAttr == nil {
+				firstAttr = arg
+			}
+			if c, ok := ast.Unparen(arg).(*ast.CallExpr); ok {
+				if key, ok := fieldKeyArg(pass, c); ok {
+					checkDuplicate(key)
+				}
+			}
+			i++
+		case isKeyString(t):
+			if firstKey == nil {
+				firstKey = arg
+			}
+			if i+1 == len(args) {
+				if text, ok := staticKey(pass, arg); ok {
+					diag(arg, fmt.Sprintf("key %q has no value (odd number of key/value arguments)", text))
+				} else {
+					diag(arg, "key has no value (odd number of key/value arguments)")
+				}
+				i++
+				continue
+			}
+			checkDuplicate(arg)
+			i += 2
+		case mayHoldKey(t):
+			// Значение интерфейсного типа может оказаться и ключом, и атрибутом:
+			// разбиение остальных аргументов на пары неизвестно.
+			return
+		default:
+			diag(arg, fmt.Sprintf("key must be a string, got %s", types.TypeString(t, types.RelativeTo(pass.Pkg))))
+			i++
+			if isStringType(t) {
+				// Именованный строковый тип — ключ, которому не хватает преобразования к string;
+				// следующий аргумент считается его значением, а не очередным ключом.
+				i++
+			}
+		}
+	}
+
+	if firstAttr != nil && firstKey != nil {
+		second := firstKey
+		if firstAttr.Pos() > firstKey.Pos() {
+			second = firstAttr
+		}
+		diag(second, "typed attributes are mixed with loose key/value pairs")
+	}
+}
+
+// isKeyString сообщает, что значение типа t будет принято как ключ. slog и zap sugar
+// проверяют ключ утверждением типа .(string), поэтому значение именованного строкового
+// типа (type Key string) ключом не считается и превращается в !BADKEY.
+func isKeyString(t types.Type) bool {
+	b, ok := types.Unalias(t).(*types.Basic)
+	return ok && (b.Kind() == types.String || b.Kind() == types.UntypedString)
+}
+
+// mayHoldKey сообщает, что значение интерфейсного типа t может быть строкой (например, any).
+func mayHoldKey(t types.Type) bool {
+	iface, ok := t.Underlying().(*types.Interface)
+	return ok && types.Implements(types.Typ[types.String], iface)
+}
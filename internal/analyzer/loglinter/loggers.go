@@ -0,0 +1,410 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/iconfire7/loglintergo/internal/config"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// loggerMethod описывает, в каком аргументе метода логгера находится сообщение.
+type loggerMethod struct {
+	msgIdx int  // индекс аргумента с сообщением или шаблоном
+	printf bool // сообщение — printf-шаблон, за которым идут аргументы
+	kv     bool // после сообщения идут пары ключ/значение (slog, Infow у zap)
+	fields bool // после сообщения идут готовые атрибуты (zap.Field, slog.Attr у LogAttrs)
+}
+
+// loggerCall — результат распознавания вызова логгера.
+type loggerCall struct {
+	kind string
+	loggerMethod
+}
+
+// msgArg возвращает аргумент вызова, в котором находится сообщение.
+func (lc loggerCall) msgArg(call *ast.CallExpr) (ast.Expr, bool) {
+	if lc.msgIdx < 0 || lc.msgIdx >= len(call.Args) {
+		return nil, false
+	}
+	return call.Args[lc.msgIdx], true
+}
+
+// hasFormatArgs сообщает, что у printf-метода после шаблона переданы аргументы.
+func (lc loggerCall) hasFormatArgs(call *ast.CallExpr) bool {
+	return lc.printf && len(call.Args) > lc.msgIdx+1
+}
+
+// slogMethods — функции пакета log/slog и методы *slog.Logger.
+var slogMethods = map[string]loggerMethod{
+	"Debug":        {msgIdx: 0, kv: true},
+	"Info":         {msgIdx: 0, kv: true},
+	"Warn":         {msgIdx: 0, kv: true},
+	"Error":        {msgIdx: 0, kv: true},
+	"DebugContext": {msgIdx: 1, kv: true},
+	"InfoContext":  {msgIdx: 1, kv: true},
+	"WarnContext":  {msgIdx: 1, kv: true},
+	"ErrorContext": {msgIdx: 1, kv: true},
+	"Log":          {msgIdx: 2, kv: true},
+	"LogAttrs":     {msgIdx: 2, fields: true},
+}
+
+// zapMethods — методы *zap.Logger. Check(level, msg) возвращает *zapcore.CheckedEntry,
+// поэтому сообщение из цепочки Check(...).Write(...) проверяется на самом Check.
+var zapMethods = map[string]loggerMethod{
+	"Debug":  {msgIdx: 0, fields: true},
+	"Info":   {msgIdx: 0, fields: true},
+	"Warn":   {msgIdx: 0, fields: true},
+	"Error":  {msgIdx: 0, fields: true},
+	"DPanic": {msgIdx: 0, fields: true},
+	"Panic":  {msgIdx: 0, fields: true},
+	"Fatal":  {msgIdx: 0, fields: true},
+	"Log":    {msgIdx: 1, fields: true},
+	"Check":  {msgIdx: 1},
+}
+
+// zapSugarMethods — методы *zap.SugaredLogger: обычные, f-, w- и ln-варианты.
+var zapSugarMethods = map[string]loggerMethod{
+	"Debug":  {msgIdx: 0},
+	"Info":   {msgIdx: 0},
+	"Warn":   {msgIdx: 0},
+	"Error":  {msgIdx: 0},
+	"DPanic": {msgIdx: 0},
+	"Panic":  {msgIdx: 0},
+	"Fatal":  {msgIdx: 0},
+	"Log":    {msgIdx: 1},
+
+	"Debugf":  {msgIdx: 0, printf: true},
+	"Infof":   {msgIdx: 0, printf: true},
+	"Warnf":   {msgIdx: 0, printf: true},
+	"Errorf":  {msgIdx: 0, printf: true},
+	"DPanicf": {msgIdx: 0, printf: true},
+	"Panicf":  {msgIdx: 0, printf: true},
+	"Fatalf":  {msgIdx: 0, printf: true},
+	"Logf":    {msgIdx: 1, printf: true},
+
+	"Debugw":  {msgIdx: 0, kv: true},
+	"Infow":   {msgIdx: 0, kv: true},
+	"Warnw":   {msgIdx: 0, kv: true},
+	"Errorw":  {msgIdx: 0, kv: true},
+	"DPanicw": {msgIdx: 0, kv: true},
+	"Panicw":  {msgIdx: 0, kv: true},
+	"Fatalw":  {msgIdx: 0, kv: true},
+	"Logw":    {msgIdx: 1, kv: true},
+
+	"Debugln":  {msgIdx: 0},
+	"Infoln":   {msgIdx: 0},
+	"Warnln":   {msgIdx: 0},
+	"Errorln":  {msgIdx: 0},
+	"DPanicln": {msgIdx: 0},
+	"Panicln":  {msgIdx: 0},
+	"Fatalln":  {msgIdx: 0},
+	"Logln":    {msgIdx: 1},
+}
+
+// logrusMethods — функции пакета logrus и методы *logrus.Logger и *logrus.Entry:
+// обычные, f- и ln-варианты.
+var logrusMethods = map[string]loggerMethod{
+	"Trace":   {msgIdx: 0},
+	"Debug":   {msgIdx: 0},
+	"Info":    {msgIdx: 0},
+	"Print":   {msgIdx: 0},
+	"Warn":    {msgIdx: 0},
+	"Warning": {msgIdx: 0},
+	"Error":   {msgIdx: 0},
+	"Fatal":   {msgIdx: 0},
+	"Panic":   {msgIdx: 0},
+	"Log":     {msgIdx: 1},
+
+	"Tracef":   {msgIdx: 0, printf: true},
+	"Debugf":   {msgIdx: 0, printf: true},
+	"Infof":    {msgIdx: 0, printf: true},
+	"Printf":   {msgIdx: 0, printf: true},
+	"Warnf":    {msgIdx: 0, printf: true},
+	"Warningf": {msgIdx: 0, printf: true},
+	"Errorf":   {msgIdx: 0, printf: true},
+	"Fatalf":   {msgIdx: 0, printf: true},
+	"Panicf":   {msgIdx: 0, printf: true},
+	"Logf":     {msgIdx: 1, printf: true},
+
+	"Traceln":   {msgIdx: 0},
+	"Debugln":   {msgIdx: 0},
+	"Infoln":    {msgIdx: 0},
+	"Println":   {msgIdx: 0},
+	"Warnln":    {msgIdx: 0},
+	"Warningln": {msgIdx: 0},
+	"Errorln":   {msgIdx: 0},
+	"Fatalln":   {msgIdx: 0},
+	"Panicln":   {msgIdx: 0},
+	"Logln":     {msgIdx: 1},
+}
+
+// stdlogMethods — функции стандартного пакета log и методы *log.Logger.
+var stdlogMethods = map[string]loggerMethod{
+	"Print":   {msgIdx: 0},
+	"Printf":  {msgIdx: 0, printf: true},
+	"Println": {msgIdx: 0},
+	"Fatal":   {msgIdx: 0},
+	"Fatalf":  {msgIdx: 0, printf: true},
+	"Fatalln": {msgIdx: 0},
+	"Panic":   {msgIdx: 0},
+	"Panicf":  {msgIdx: 0, printf: true},
+	"Panicln": {msgIdx: 0},
+}
+
+// zerologEventMethods — завершающие методы *zerolog.Event. Уровень задаётся в начале
+// цепочки (log.Info(), logger.Err(err), ...), а сообщение — в последнем вызове.
+// У Send сообщения нет, но поля цепочки всё равно проверяются.
+var zerologEventMethods = map[string]loggerMethod{
+	"Msg":  {msgIdx: 0},
+	"Msgf": {msgIdx: 0, printf: true},
+	"Send": {msgIdx: -1},
+}
+
+const (
+	stdlogPath  = "log"
+	slogPath    = "log/slog"
+	zapPath     = "go.uber.org/zap"
+	logrusPath  = "github.com/sirupsen/logrus"
+	zerologPath = "github.com/rs/zerolog"
+)
+
+// loggerKind связывает имя вида логгера с таблицей его методов.
+type loggerKind struct {
+	kind    string
+	methods map[string]loggerMethod
+}
+
+// loggerType — тип-получатель логгера: путь пакета и имя типа.
+type loggerType struct {
+	pkgPath  string
+	typeName string
+}
+
+// loggerPackages — пакеты, функции уровня пакета которых являются логированием.
+var loggerPackages = map[string]loggerKind{
+	stdlogPath: {kind: "stdlog", methods: stdlogMethods},
+	slogPath:   {kind: "slog", methods: slogMethods},
+	logrusPath: {kind: "logrus", methods: logrusMethods},
+}
+
+// loggerTypes — типы, методы которых являются логированием.
+var loggerTypes = map[loggerType]loggerKind{
+	{stdlogPath, "Logger"}:     {kind: "stdlog", methods: stdlogMethods},
+	{slogPath, "Logger"}:       {kind: "slog", methods: slogMethods},
+	{zapPath, "Logger"}:        {kind: "zap", methods: zapMethods},
+	{zapPath, "SugaredLogger"}: {kind: "zap-sugar", methods: zapSugarMethods},
+	{logrusPath, "Logger"}:     {kind: "logrus", methods: logrusMethods},
+	{logrusPath, "Entry"}:      {kind: "logrus", methods: logrusMethods},
+	{zerologPath, "Event"}:     {kind: "zerolog", methods: zerologEventMethods},
+}
+
+// lookup ищет метод name в таблице вида логгера.
+func (k loggerKind) lookup(name string) (loggerCall, bool) {
+	m, ok := k.methods[name]
+	if !ok {
+		return loggerCall{}, false
+	}
+	return loggerCall{kind: k.kind, loggerMethod: m}, true
+}
+
+// detector распознаёт вызовы логгеров по встроенным таблицам и пользовательской конфигурации.
+type detector struct {
+	packages map[string][]loggerKind
+	types    map[loggerType][]loggerKind
+	// shapes — методы, которые распознаются на любом интерфейсе подходящей формы.
+	shapes map[string][]loggerMethod
+}
+
+// newDetector строит detector из встроенных таблиц и обёрток, описанных в конфигурации.
+func newDetector(custom []config.Logger) *detector {
+	d := &detector{
+		packages: make(map[string][]loggerKind, len(loggerPackages)),
+		types:    make(map[loggerType][]loggerKind, len(loggerTypes)),
+		shapes:   make(map[string][]loggerMethod),
+	}
+	for path, k := range loggerPackages {
+		d.packages[path] = append(d.packages[path], k)
+	}
+	for t, k := range loggerTypes {
+		d.types[t] = append(d.types[t], k)
+	}
+
+	for _, l := range custom {
+		k := loggerKind{kind: l.Kind, methods: make(map[string]loggerMethod, len(l.Methods))}
+		if k.kind == "" {
+			k.kind = l.Package
+		}
+		for _, name := range l.Methods {
+			m := loggerMethod{msgIdx: l.MessageIndex, printf: l.Printf}
+			k.methods[name] = m
+			d.shapes[name] = append(d.shapes[name], m)
+		}
+
+		// Пользовательские описания проверяются раньше встроенных.
+		if l.Receiver == "" {
+			d.packages[l.Package] = append([]loggerKind{k}, d.packages[l.Package]...)
+			continue
+		}
+		t := loggerType{pkgPath: l.Package, typeName: l.Receiver}
+		d.types[t] = append([]loggerKind{k}, d.types[t]...)
+	}
+	return d
+}
+
+// detectLoggerCall определяет, является ли вызов CallExpr логированием через один из
+// известных логгеров (log, slog, zap, logrus, zerolog), пользовательских обёрток из
+// конфигурации, интерфейсов подходящей формы или обёрток, найденных автоматически
+// (см. wrapperFact),
+// и в каком аргументе находится сообщение. Вызываемая функция определяется по типам,
+// поэтому методы, полученные через встраивание, тоже распознаются.
+func (d *detector) detectLoggerCall(pass *analysis.Pass, call *ast.CallExpr) (loggerCall, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return loggerCall{}, false
+	}
+
+	sig := fn.Type().(*types.Signature)
+
+	var kinds []loggerKind
+	if recv := sig.Recv(); recv == nil {
+		kinds = d.packages[fn.Pkg().Path()]
+	} else if named := derefNamed(recv.Type()); named != nil && named.Obj().Pkg() != nil {
+		kinds = d.types[loggerType{pkgPath: named.Obj().Pkg().Path(), typeName: named.Obj().Name()}]
+	}
+
+	for _, k := range kinds {
+		if lc, ok := k.lookup(fn.Name()); ok {
+			return lc, true
+		}
+	}
+
+	// Метод интерфейса, в том числе метод параметра типа из его ограничения:
+	// func G[T interface{ Info(string, ...any) }](l T) { l.Info(...) }.
+	if recv := sig.Recv(); recv != nil && types.IsInterface(recv.Type()) {
+		return d.interfaceCall(pass, call, fn)
+	}
+	return wrapperCall(pass, call)
+}
+
+// interfaceLevels — уровни, по именам которых метод интерфейса считается методом логгера.
+var interfaceLevels = []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "DPanic", "Panic", "Fatal"}
+
+// interfaceSkipPackages — пакеты, интерфейсы которых похожи на логгеры, но ими не являются
+// (например, testing.TB с методами Errorf/Fatalf).
+var interfaceSkipPackages = map[string]bool{
+	"testing": true,
+}
+
+// interfaceCall распознаёт вызов через пользовательский интерфейс логгера, например
+// type Logger interface { Info(msg string, args ...any) }. Метод должен называться как
+// метод логгера (встроенные уровни или методы из конфигурации) и иметь подходящую форму:
+// строковое сообщение, за которым следует вариативный параметр. Вид логгера в диагностиках —
+// имя интерфейса.
+func (d *detector) interfaceCall(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) (loggerCall, bool) {
+	if interfaceSkipPackages[fn.Pkg().Path()] {
+		return loggerCall{}, false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	candidates := append([]loggerMethod(nil), d.shapes[fn.Name()]...)
+	if m, ok := builtinShape(fn.Name(), sig); ok {
+		candidates = append(candidates, m)
+	}
+
+	for _, m := range candidates {
+		if !sig.Variadic() || m.msgIdx >= sig.Params().Len()-1 {
+			continue
+		}
+		if b, ok := sig.Params().At(m.msgIdx).Type().Underlying().(*types.Basic); !ok || b.Kind() != types.String {
+			continue
+		}
+		return loggerCall{kind: interfaceName(pass, call, fn), loggerMethod: m}, true
+	}
+	return loggerCall{}, false
+}
+
+// builtinShape сопоставляет имя метода интерфейса с уровнями логгера: Info, Infof, Infow,
+// Infoln и InfoContext. У Context-вариантов первым параметром должен быть context.Context.
+func builtinShape(name string, sig *types.Signature) (loggerMethod, bool) {
+	for _, level := range interfaceLevels {
+		rest, ok := strings.CutPrefix(name, level)
+		if !ok {
+			continue
+		}
+		switch rest {
+		case "", "ln":
+			return loggerMethod{msgIdx: 0}, true
+		case "w":
+			return loggerMethod{msgIdx: 0, kv: true}, true
+		case "f":
+			return loggerMethod{msgIdx: 0, printf: true}, true
+		case "Context":
+			if sig.Params().Len() == 0 || !isNamedType(sig.Params().At(0).Type(), "context", "Context") {
+				return loggerMethod{}, false
+			}
+			return loggerMethod{msgIdx: 1}, true
+		}
+	}
+	return loggerMethod{}, false
+}
+
+// interfaceName возвращает имя интерфейса, через который сделан вызов, в виде pkg.Name.
+func interfaceName(pass *analysis.Pass, call *ast.CallExpr, fn *types.Func) string {
+	t := fn.Type().(*types.Signature).Recv().Type()
+	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+		if xt := pass.TypesInfo.TypeOf(sel.X); xt != nil {
+			t = xt
+		}
+	}
+	if tp, ok := types.Unalias(t).(*types.TypeParam); ok {
+		// Для параметра типа вид логгера — имя ограничения, если оно объявлено.
+		t = tp.Constraint()
+	}
+	named := derefNamed(t)
+	if named == nil || named.Obj().Pkg() == nil {
+		return "interface"
+	}
+	return named.Obj().Pkg().Name() + "." + named.Obj().Name()
+}
+
+// derefNamed убирает указатель и возвращает именованный тип
+func derefNamed(t types.Type) *types.Named {
+	if p, ok := types.Unalias(t).(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	if n, ok := types.Unalias(t).(*types.Named); ok {
+		return n
+	}
+	return nil
+}
+
+// isNamedType сообщает, что t (возможно, указатель) — именованный тип typeName из пакета pkgPath.
+func isNamedType(t types.Type, pkgPath, typeName string) bool {
+	if t == nil {
+		return false
+	}
+	named := derefNamed(t)
+	if named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
+		return false
+	}
+	return named.Obj().Pkg().Path() == pkgPath && named.Obj().Name() == typeName
+}
+
+// fieldKeyArg возвращает аргумент-ключ, если первый параметр вызываемой функции — key string.
+func fieldKeyArg(pass *analysis.Pass, call *ast.CallExpr) (ast.Expr, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || len(call.Args) == 0 {
+		return nil, false
+	}
+	params := fn.Type().(*types.Signature).Params()
+	if params.Len() == 0 || params.At(0).Name() != "key" {
+		return nil, false
+	}
+	if b, ok := params.At(0).Type().Underlying().(*types.Basic); !ok || b.Kind() != types.String {
+		return nil, false
+	}
+	return call.Args[0], true
+}
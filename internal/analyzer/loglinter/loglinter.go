@@ -7,127 +7,262 @@ import (
 	"go/types"
 	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/iconfire7/loglintergo/internal/printf"
 	"github.com/iconfire7/loglintergo/internal/rules"
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
 )
 
-var logMethods = map[string]bool{
-	"Debug": true,
-	"Info":  true,
-	"Warn":  true,
-	"Error": true,
+// linter — конфигурация линтера, подготовленная для анализа пакетов.
+type linter struct {
+	cfg       config.Config
+	detector  *detector
+	sensitive []*regexp.Regexp
+	keyStyle  rules.KeyStyle
+	taint     *taintAnalysis
+	dangerous *dangerousCatalog
 }
 
 func New(cfg config.Config, sensitive []*regexp.Regexp) *analysis.Analyzer {
+	requires := []*analysis.Analyzer{inspect.Analyzer}
+	if cfg.SSA || cfg.Taint.Enabled {
+		requires = append(requires, buildssa.Analyzer)
+	}
+
+	l := &linter{
+		cfg:       cfg,
+		detector:  newDetector(cfg.Loggers),
+		sensitive: sensitive,
+		dangerous: newDangerousCatalog(cfg.DangerousValues),
+	}
+	// Соглашение уже проверено при разборе настроек плагина; при ошибке правило LOG005 не применяется.
+	if cfg.Rules.AttrKeys {
+		l.keyStyle, _ = rules.CompileKeyStyle(cfg.AttrKeyStyle, cfg.AttrKeyPattern)
+	}
+	if cfg.Taint.Enabled {
+		l.taint = newTaintAnalysis(cfg.Taint)
+	}
+
 	return &analysis.Analyzer{
-		Name:     "loglintergo",
-		Doc:      "checks log messages for style/safety rules",
-		Requires: []*analysis.Analyzer{inspect.Analyzer},
-		Run:      run(cfg, sensitive),
+		Name:      "loglintergo",
+		Doc:       "checks log messages for style/safety rules",
+		Requires:  requires,
+		Run:       l.run(),
+		FactTypes: []analysis.Fact{new(wrapperFact), new(leakFact)},
 	}
 }
 
 // run — основная функция анализа пакета.
-func run(cfg config.Config, sensitive []*regexp.Regexp) func(pass *analysis.Pass) (any, error) {
+func (l *linter) run() func(pass *analysis.Pass) (any, error) {
+	cfg, d := l.cfg, l.detector
 	return func(pass *analysis.Pass) (any, error) {
 		ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 
+		exportWrapperFacts(pass, d)
+
+		var ssaInfo *buildssa.SSA
+		if cfg.SSA || cfg.Taint.Enabled {
+			ssaInfo = pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+		}
+
+		// Сообщение-константа может использоваться в нескольких вызовах, а диагностика по ней
+		// ставится на объявление; одинаковые диагностики выводятся один раз.
+		reported := map[token.Pos]map[string]bool{}
+		report := func(diag analysis.Diagnostic) {
+			if reported[diag.Pos] == nil {
+				reported[diag.Pos] = map[string]bool{}
+			}
+			if reported[diag.Pos][diag.Message] {
+				return
+			}
+			reported[diag.Pos][diag.Message] = true
+			pass.Report(diag)
+		}
+
+		if cfg.Rules.RedactionLeaks {
+			l.exportLeakFacts(pass, report)
+		}
+
 		ins.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
 			call := n.(*ast.CallExpr)
 
-			kind := detectLoggerCall(pass, call)
-			if kind == "" {
+			l.checkAttrs(pass, call, report)
+			l.checkKeyValues(pass, call, report)
+			l.checkSecretFields(pass, call, report)
+			l.checkDangerousValues(pass, call, report)
+			if l.taint != nil {
+				l.checkTaint(pass, ssaInfo, call, report)
+			}
+
+			lc, ok := d.detectLoggerCall(pass, call)
+			if !ok {
 				return
 			}
+			kind := lc.kind
 
-			msg, pos, ok := extractFirstStringArg(pass, call)
+			msgExpr, ok := lc.msgArg(call)
 			if !ok {
 				return
 			}
 
-			violations := rules.CheckAll(msg, cfg.Rules, sensitive)
-			if len(violations) == 0 {
+			// Правки LOG010 и LOG012 переписывают сообщение целиком и предлагаются один раз.
+			rewritten := false
+			if l.taint != nil && cfg.Rules.Injection {
+				rewritten = l.checkInjection(pass, ssaInfo, call, lc, msgExpr, report)
+			}
+			if cfg.Rules.Interpolation {
+				rewritten = l.checkInterpolation(pass, call, lc, msgExpr, !rewritten, report) || rewritten
+			}
+			if cfg.Rules.PrintfVerbs {
+				l.checkPrintfVerbs(pass, call, lc, msgExpr, report)
+			}
+			if cfg.Rules.PrintfArgs {
+				l.checkPrintfArgs(pass, call, lc, msgExpr, report)
+			}
+
+			if cfg.Rules.StaticMessage {
+				l.checkStaticMessage(pass, lc, msgExpr, report)
+			}
+
+			if site, ok := directSite(pass, call, lc, msgExpr); ok {
+				site.rewritten = rewritten
+				l.checkMessage(pass, kind, site, report)
 				return
 			}
 
-			hasSensitiveDynamic := false
-			if len(call.Args) > 0 {
-				for _, v := range violations {
-					if v.ID == rules.RSensitive && HasDynamicTail(pass, call.Args[0]) {
-						hasSensitiveDynamic = true
-						break
-					}
+			// Сообщение в переменной: проверяем значения, которые до неё доходят.
+			if cfg.SSA {
+				for _, site := range ssaSites(pass, ssaInfo, call, lc, msgExpr) {
+					l.checkMessage(pass, kind, site, report)
 				}
 			}
+		})
 
-			fixableViolationID, fixableText, hasFixableViolation := pickSingleSuggestedFix(violations, msg)
+		return nil, nil
+	}
+}
 
-			for _, v := range violations {
-				diag := analysis.Diagnostic{
-					Pos:     pos,
-					Message: string(v.ID) + " " + v.Message + " (" + kind + ")",
-				}
+// msgSite — проверяемое сообщение и место, куда ставятся диагностики по нему.
+type msgSite struct {
+	expr    ast.Expr // выражение с текстом сообщения
+	text    string   // статический текст сообщения
+	pos     token.Pos
+	end     token.Pos // конец диапазона диагностики о сообщении целиком
+	related []analysis.RelatedInformation
+	// printf — expr является шаблоном printf-метода, после которого в вызове есть аргументы.
+	printf bool
+	// wholePos, wholeEnd — диапазон, заменяемый при удалении чувствительных данных.
+	wholePos, wholeEnd token.Pos
+	// rewritten — сообщение переписывает правка LOG010 или LOG012, правки текста не предлагаются.
+	rewritten bool
+}
 
-				if v.ID == rules.RSensitive && !hasSensitiveDynamic {
-					continue
-				}
+// directSite строит msgSite для сообщения, текст которого виден прямо в аргументе вызова.
+func directSite(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr) (msgSite, bool) {
+	msg, pos, ok := extractFirstStringArg(pass, call, lc)
+	if !ok {
+		return msgSite{}, false
+	}
 
-				if hasSensitiveDynamic {
-					if v.ID != rules.RSensitive {
-						pass.Report(diag)
-						continue
-					}
-
-					if prefix, ok := safePrefixForSensitive(pass, call.Args[0]); ok {
-						if fixPos, fixEnd, ok2 := fixTargetForFirstArgWhole(call); ok2 {
-							diag.SuggestedFixes = []analysis.SuggestedFix{
-								{
-									Message: "remove sensitive dynamic data from log message",
-									TextEdits: []analysis.TextEdit{
-										{
-											Pos:     fixPos,
-											End:     fixEnd,
-											NewText: []byte(strconv.Quote(prefix)),
-										},
-									},
-								},
-							}
-						}
-					}
-
-					pass.Report(diag)
-					continue
-				}
+	site := msgSite{expr: msgExpr, text: msg, pos: pos, end: msgExpr.End(), printf: lc.hasFormatArgs(call)}
+	site.wholePos, site.wholeEnd, _ = fixTargetForFirstArgWhole(call, lc)
+
+	if c, valueExpr := messageConst(pass, msgExpr); c != nil {
+		if valueExpr != nil {
+			site.pos, site.end = valueExpr.Pos(), valueExpr.End()
+			site.related = []analysis.RelatedInformation{{
+				Pos:     msgExpr.Pos(),
+				End:     msgExpr.End(),
+				Message: "constant " + c.Name() + " is used as log message here",
+			}}
+		} else {
+			site.related = []analysis.RelatedInformation{{
+				Pos:     c.Pos(),
+				Message: "constant " + c.Name() + " is declared here",
+			}}
+		}
+	}
+	return site, true
+}
+
+// checkMessage применяет правила к тексту сообщения и сообщает о нарушениях с автоисправлениями.
+func (l *linter) checkMessage(pass *analysis.Pass, kind string, site msgSite, report func(analysis.Diagnostic)) {
+	msg := site.text
+	// Правила применяются только к литеральному тексту: директивы шаблонов замаскированы.
+	checked, mask, pieces := checkedText(pass, site)
+	violations := rules.CheckAll(checked, mask, l.cfg.Rules, l.sensitive)
+	if len(violations) == 0 {
+		return
+	}
+
+	hasSensitiveDynamic := false
+	for _, v := range violations {
+		if v.ID == rules.RSensitive && (HasDynamicTail(pass, site.expr) || site.printf) {
+			hasSensitiveDynamic = true
+			break
+		}
+	}
+
+	fixableViolationID, _, hasFixableViolation := pickSingleSuggestedFix(violations, msg, mask)
+
+	for _, v := range violations {
+		pos, end := violationRange(pass, site, pieces, v)
+		diag := analysis.Diagnostic{
+			Pos:     pos,
+			End:     end,
+			Message: string(v.ID) + " " + v.Message + " (" + kind + ")",
+			Related: site.related,
+		}
 
-				if hasFixableViolation && v.ID == fixableViolationID {
-					if fixPos, fixEnd, ok2 := fixTargetForFirstArg(pass, call); ok2 {
-						diag.SuggestedFixes = []analysis.SuggestedFix{
+		if v.ID == rules.RSensitive && !hasSensitiveDynamic {
+			continue
+		}
+
+		if hasSensitiveDynamic {
+			if v.ID != rules.RSensitive {
+				report(diag)
+				continue
+			}
+
+			if prefix, ok := safePrefixForMessage(pass, site.expr, site.printf); ok && site.wholePos.IsValid() {
+				diag.SuggestedFixes = []analysis.SuggestedFix{
+					{
+						Message: "remove sensitive dynamic data from log message",
+						TextEdits: []analysis.TextEdit{
 							{
-								Message: "apply fix for " + string(v.ID),
-								TextEdits: []analysis.TextEdit{
-									{
-										Pos:     fixPos,
-										End:     fixEnd,
-										NewText: []byte(strconv.Quote(fixableText)),
-									},
-								},
+								Pos:     site.wholePos,
+								End:     site.wholeEnd,
+								NewText: []byte(strconv.Quote(prefix)),
 							},
-						}
-					}
+						},
+					},
 				}
+			}
+
+			report(diag)
+			continue
+		}
 
-				pass.Report(diag)
+		if hasFixableViolation && v.ID == fixableViolationID && !site.rewritten {
+			if edits := fixEdits(pass, site.expr, v.ID, site.printf); len(edits) > 0 {
+				diag.SuggestedFixes = []analysis.SuggestedFix{
+					{
+						Message:   "apply fix for " + string(v.ID),
+						TextEdits: edits,
+					},
+				}
 			}
-		})
+		}
 
-		return nil, nil
+		report(diag)
 	}
 }
 
-func pickSingleSuggestedFix(vs []rules.Violation, msg string) (rules.RuleID, string, bool) {
+func pickSingleSuggestedFix(vs []rules.Violation, msg string, mask rules.Mask) (rules.RuleID, string, bool) {
 	order := []rules.RuleID{rules.RSensitive, rules.RNoEmojiSpecial, rules.RLowercaseStart}
 
 	present := map[rules.RuleID]struct{}{}
@@ -139,7 +274,7 @@ func pickSingleSuggestedFix(vs []rules.Violation, msg string) (rules.RuleID, str
 		if _, ok := present[id]; !ok {
 			continue
 		}
-		fixed, ok := suggestFixForViolation(id, msg)
+		fixed, ok := suggestFixForViolation(id, msg, mask)
 		if !ok {
 			continue
 		}
@@ -151,70 +286,13 @@ func pickSingleSuggestedFix(vs []rules.Violation, msg string) (rules.RuleID, str
 	return "", "", false
 }
 
-// detectLoggerCall определяет, является ли вызов CallExpr логированием через slog или zap.
-func detectLoggerCall(pass *analysis.Pass, call *ast.CallExpr) string {
-	sel, ok := call.Fun.(*ast.SelectorExpr)
+// extractFirstStringArg извлекает строковое сообщение логгера из аргумента, на который указывает lc.
+func extractFirstStringArg(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall) (msg string, pos token.Pos, ok bool) {
+	expr, ok := lc.msgArg(call)
 	if !ok {
-		return ""
-	}
-
-	if pkgIdent, ok := sel.X.(*ast.Ident); ok {
-		if pkgName, ok := pass.TypesInfo.Uses[pkgIdent].(*types.PkgName); ok {
-			if pkgName.Imported() != nil && pkgName.Imported().Path() == "log/slog" {
-				if logMethods[sel.Sel.Name] {
-					return "slog"
-				}
-			}
-		}
-	}
-
-	recvT := pass.TypesInfo.TypeOf(sel.X)
-	if recvT == nil {
-		return ""
-	}
-	named := derefNamed(recvT)
-	if named == nil || named.Obj() == nil || named.Obj().Pkg() == nil {
-		return ""
-	}
-	pkgPath := named.Obj().Pkg().Path()
-	typeName := named.Obj().Name()
-
-	if logMethods[sel.Sel.Name] {
-		// slog: *slog.Logger
-		if pkgPath == "log/slog" && typeName == "Logger" {
-			return "slog"
-		}
-		// zap: *zap.Logger
-		if pkgPath == "go.uber.org/zap" && typeName == "Logger" {
-			return "zap"
-		}
-		// zap: *zap.SugaredLogger
-		if pkgPath == "go.uber.org/zap" && typeName == "SugaredLogger" {
-			return "zap-sugar"
-		}
-	}
-
-	return ""
-}
-
-// derefNamed убирает указатель и возвращает именованный тип
-func derefNamed(t types.Type) *types.Named {
-	if p, ok := t.(*types.Pointer); ok {
-		t = p.Elem()
-	}
-	if n, ok := t.(*types.Named); ok {
-		return n
-	}
-	return nil
-}
-
-// extractFirstStringArg извлекает строковое сообщение логгера.
-func extractFirstStringArg(pass *analysis.Pass, call *ast.CallExpr) (msg string, pos token.Pos, ok bool) {
-	if len(call.Args) == 0 {
 		return "", token.NoPos, false
 	}
 
-	expr := call.Args[0]
 	s, ok := extractStaticText(pass, expr)
 	if !ok {
 		return "", token.NoPos, false
@@ -222,155 +300,119 @@ func extractFirstStringArg(pass *analysis.Pass, call *ast.CallExpr) (msg string,
 	return s, expr.Pos(), true
 }
 
-// extractStaticText извлекает статический текст из выражения.
+// extractStaticText извлекает статический текст из выражения: склеивает статические части
+// сообщения (см. messageParts), пропуская динамические.
+// Любое константное строковое выражение (литерал, именованная константа, pkg.Const,
+// типизированная строковая константа) вычисляется по pass.TypesInfo.
 func extractStaticText(pass *analysis.Pass, expr ast.Expr) (string, bool) {
-	switch e := expr.(type) {
-
-	// "literal"
-	case *ast.BasicLit:
-		if e.Kind != token.STRING {
-			return "", false
-		}
-		s, err := strconv.Unquote(e.Value)
-		if err != nil {
-			return "", false
-		}
-		return s, true
-
-	case *ast.BinaryExpr:
-		if e.Op != token.ADD {
-			return "", false
-		}
-
-		if pass != nil && pass.TypesInfo != nil {
-			if t := pass.TypesInfo.TypeOf(e); t != nil && t.String() != "string" {
-				return "", false
-			}
-		}
+	return joinStatic(messageParts(pass, expr))
+}
 
-		if left, ok := extractStaticText(pass, e.X); ok {
-			if right, ok2 := extractStaticText(pass, e.Y); ok2 {
-				return left + right, true
-			}
-			return left, true
-		}
-		if right, ok := extractStaticText(pass, e.Y); ok {
-			return right, true
-		}
-		return "", false
+// isStringType сообщает, что базовый тип t — строка.
+func isStringType(t types.Type) bool {
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsString != 0
+}
 
+// messageConst возвращает константу, на которую ссылается сообщение (msg, pkg.Msg),
+// и выражение её значения, если константа объявлена в анализируемом пакете.
+func messageConst(pass *analysis.Pass, expr ast.Expr) (*types.Const, ast.Expr) {
+	var id *ast.Ident
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
 	case *ast.CallExpr:
-		if isFmtSprintf(pass, e) {
-			if len(e.Args) == 0 {
-				return "", false
-			}
-			return extractStaticText(pass, e.Args[0])
+		// Преобразование типа: string(msgs.Started).
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return messageConst(pass, e.Args[0])
 		}
-		return "", false
-
-	case *ast.ParenExpr:
-		return extractStaticText(pass, e.X)
-
+		return nil, nil
 	default:
-		return "", false
-	}
-}
-
-// isFmtSprintf проверяет, что выражение — это именно fmt.Sprintf.
-func isFmtSprintf(pass *analysis.Pass, call *ast.CallExpr) bool {
-	sel, ok := call.Fun.(*ast.SelectorExpr)
-	if !ok {
-		return false
-	}
-	if sel.Sel == nil || sel.Sel.Name != "Sprintf" {
-		return false
+		return nil, nil
 	}
 
-	id, ok := sel.X.(*ast.Ident)
+	c, ok := pass.TypesInfo.Uses[id].(*types.Const)
 	if !ok {
-		return false
+		return nil, nil
 	}
-
-	if pass == nil || pass.TypesInfo == nil {
-		return false
+	if c.Pkg() != pass.Pkg {
+		return c, nil
 	}
 
-	pkgName, ok := pass.TypesInfo.Uses[id].(*types.PkgName)
-	if !ok || pkgName.Imported() == nil {
-		return false
+	for _, f := range pass.Files {
+		if c.Pos() < f.FileStart || c.Pos() > f.FileEnd {
+			continue
+		}
+		var value ast.Expr
+		ast.Inspect(f, func(n ast.Node) bool {
+			spec, ok := n.(*ast.ValueSpec)
+			if !ok || value != nil {
+				return value == nil
+			}
+			for i, name := range spec.Names {
+				if name.Pos() == c.Pos() && i < len(spec.Values) {
+					value = spec.Values[i]
+				}
+			}
+			return false
+		})
+		return c, value
 	}
-
-	return pkgName.Imported().Path() == "fmt"
+	return c, nil
 }
 
 // HasDynamicTail возвращает true, если первый аргумент потенциально добавляет динамические данные.
 func HasDynamicTail(pass *analysis.Pass, expr ast.Expr) bool {
-	switch e := expr.(type) {
-	case *ast.BinaryExpr:
-		if e.Op != token.ADD {
-			return false
-		}
-		_, lok := extractStaticText(pass, e.X)
-		_, rok := extractStaticText(pass, e.Y)
-		return !lok || !rok
-
-	case *ast.CallExpr:
-		return isFmtSprintf(pass, e) && len(e.Args) > 1
-
-	default:
-		return false
-	}
+	return hasDynamicPart(messageParts(pass, expr))
 }
 
-// safePrefixForSensitive строит безопасный префикс, который НЕ печатает значение секрета.
+// safePrefixForSensitive строит безопасный префикс, который НЕ печатает значение секрета:
+// остаются только статические части, а printf-шаблоны обрезаются по первой директиве.
 func safePrefixForSensitive(pass *analysis.Pass, expr ast.Expr) (string, bool) {
-	switch e := expr.(type) {
-	case *ast.BinaryExpr:
-		if e.Op != token.ADD {
-			return "", false
-		}
-		s, ok := extractStaticText(pass, e)
-		if !ok {
-			return "", false
+	var b strings.Builder
+	found := false
+	for _, p := range messageParts(pass, expr) {
+		if !p.static {
+			continue
 		}
-		return s, true
-
-	case *ast.CallExpr:
-		if isFmtSprintf(pass, e) && len(e.Args) > 0 {
-			formatStr, ok := extractStaticText(pass, e.Args[0])
-			if !ok {
-				return "", false
-			}
-			prefix := stripFmtDirectives(formatStr)
-			return prefix, true
+		found = true
+		if p.format {
+			b.WriteString(printf.Prefix(p.text))
+			continue
 		}
-		return "", false
-
-	default:
-		return "", false
+		b.WriteString(p.text)
 	}
+	return b.String(), found
 }
 
-// stripFmtDirectives отрезает format string по первому реальному спецификатору '%'
-func stripFmtDirectives(format string) string {
-	for i := 0; i < len(format); i++ {
-		if format[i] != '%' {
-			continue
-		}
-		// "%%" -> literal '%'
-		if i+1 < len(format) && format[i+1] == '%' {
-			i++
-			continue
-		}
-		return format[:i]
+// safePrefixForMessage — safePrefixForSensitive с учётом printf-методов логгера:
+// у них сообщение само является шаблоном, поэтому '%' в префиксе снова экранируется.
+func safePrefixForMessage(pass *analysis.Pass, expr ast.Expr, template bool) (string, bool) {
+	if !template {
+		return safePrefixForSensitive(pass, expr)
 	}
-	return format
+	format, ok := extractStaticText(pass, expr)
+	if !ok {
+		return "", false
+	}
+	return strings.ReplaceAll(printf.Prefix(format), "%", "%%"), true
 }
 
-// fixTargetForFirstArgWhole возвращает диапазон исходника, который нужно заменить
-func fixTargetForFirstArgWhole(call *ast.CallExpr) (pos, end token.Pos, ok bool) {
-	if len(call.Args) == 0 {
+// fixTargetForFirstArgWhole возвращает диапазон исходника, который нужно заменить.
+// Для printf-методов в диапазон попадают и аргументы шаблона, иначе они останутся лишними.
+func fixTargetForFirstArgWhole(call *ast.CallExpr, lc loggerCall) (pos, end token.Pos, ok bool) {
+	expr, ok := lc.msgArg(call)
+	if !ok {
 		return token.NoPos, token.NoPos, false
 	}
-	return call.Args[0].Pos(), call.Args[0].End(), true
+	if lc.hasFormatArgs(call) {
+		end := call.Args[len(call.Args)-1].End()
+		if call.Ellipsis.IsValid() {
+			end = call.Ellipsis + token.Pos(len("..."))
+		}
+		return expr.Pos(), end, true
+	}
+	return expr.Pos(), expr.End(), true
 }
@@ -0,0 +1,376 @@
+package loglinter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/iconfire7/loglintergo/internal/config"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// newTestAnalyzer собирает анализатор так же, как плагин: шаблоны чувствительных данных
+// компилируются из конфигурации.
+func newTestAnalyzer(t *testing.T, cfg config.Config) *analysis.Analyzer {
+	t.Helper()
+	re := make([]*regexp.Regexp, 0, len(cfg.SensitivePatterns))
+	for _, p := range cfg.SensitivePatterns {
+		re = append(re, regexp.MustCompile(p))
+	}
+	return New(cfg, re)
+}
+
+func TestSlogForms(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "slogforms")
+}
+
+func TestZapForms(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "zapforms")
+}
+
+func TestLogrusForms(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "logrusforms")
+}
+
+func TestZerologForms(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "zerologforms")
+}
+
+func TestStdlogForms(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "stdlogforms")
+}
+
+func TestCustomLoggers(t *testing.T) {
+	cfg := config.Default()
+	cfg.Loggers = []config.Logger{
+		{Kind: "obs", Package: "obs", Receiver: "L", Methods: []string{"Infow"}},
+		{Kind: "obs", Package: "obs", Receiver: "L", Methods: []string{"Debugf"}, Printf: true},
+		{Kind: "audit", Package: "audit", Methods: []string{"Record"}, MessageIndex: 1},
+		{Kind: "audit", Package: "audit", Receiver: "Recorder", Methods: []string{"Record"}, MessageIndex: 1},
+	}
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "customloggers")
+}
+
+func TestWrapperFacts(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "wrapbase", "wrapmid", "wrapuse")
+}
+
+func TestInterfaces(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "ifaces")
+}
+
+func TestConstants(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "consts")
+}
+
+func TestStringFuncs(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "strfuncs")
+}
+
+func TestSSAVars(t *testing.T) {
+	cfg := config.Default()
+	cfg.SSA = true
+	cfg.Loggers = []config.Logger{{Kind: "obs", Package: "obs", Receiver: "L", Methods: []string{"Infow"}}}
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "ssavars")
+}
+
+func TestAttrKeys(t *testing.T) {
+	cfg := config.Default()
+	cfg.Rules.AttrKeys = true
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "attrkeys")
+
+	cfg.AttrKeyStyle = "camelCase"
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "attrkeyscamel")
+}
+
+func TestKeyValues(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "kvpairs")
+}
+
+func TestSensitiveKeys(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "sensitivekeys")
+}
+
+func TestSecretFields(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "secretfields")
+}
+
+func TestRedactionMethods(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "secrets", "redactuse")
+}
+
+func TestTaint(t *testing.T) {
+	cfg := config.Default()
+	cfg.Loggers = []config.Logger{
+		{Kind: "obs", Package: "obs", Receiver: "L", Methods: []string{"Infow"}},
+		{Kind: "obs", Package: "obs", Receiver: "L", Methods: []string{"Debugf"}, Printf: true},
+	}
+	cfg.Taint = config.Taint{
+		Enabled: true,
+		Sources: []config.TaintFunc{
+			{Package: "webx", Receiver: "Header", Methods: []string{"Get"}},
+			{Package: "webx", Receiver: "Request", Methods: []string{"FormValue"}},
+			{Package: "webx", Methods: []string{"Getenv"}},
+		},
+		Sanitizers: []config.TaintFunc{{Package: "webx", Methods: []string{"Hash"}}},
+		Sinks:      []config.TaintFunc{{Package: "webx", Methods: []string{"Audit"}}},
+		Escapers:   []config.TaintFunc{{Package: "webx", Methods: []string{"Quote"}}},
+	}
+	results := analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "taintflow")
+
+	// Путь от источника до вызова логгера выводится в связанной информации.
+	for _, r := range results {
+		for _, d := range r.Diagnostics {
+			if strings.HasPrefix(d.Message, string(rules.RTaint)) && len(d.Related) == 0 {
+				t.Errorf("%v: %s: no related information", r.Pass.Fset.Position(d.Pos), d.Message)
+			}
+		}
+	}
+}
+
+// TestDefaultTaintSources проверяет встроенный каталог источников на заглушках net/http,
+// net/url, os и flag из testdata/src. analysistest берёт стандартные пакеты из GOROOT,
+// поэтому пакеты загружаются через loadStubPackages.
+func TestDefaultTaintSources(t *testing.T) {
+	cfg := config.Default()
+	cfg.Loggers = []config.Logger{{Kind: "obs", Package: "obs", Receiver: "L", Methods: []string{"Infow"}}}
+	cfg.Taint.Enabled = true
+	graph, err := checker.Analyze([]*analysis.Analyzer{newTestAnalyzer(t, cfg)}, loadStubPackages(t, "taintdefaults"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, act := range graph.Roots {
+		if act.Err != nil {
+			t.Fatal(act.Err)
+		}
+		checkWants(t, act.Package, act.Diagnostics)
+	}
+}
+
+// loadStubPackages разбирает и проверяет пакеты testdata/src без go list: импорты
+// разрешаются только в testdata/src, и заглушки подменяют стандартные пакеты.
+func loadStubPackages(t *testing.T, paths ...string) []*packages.Package {
+	t.Helper()
+	fset := token.NewFileSet()
+	sizes := types.SizesFor("gc", "amd64")
+	loaded := map[string]*packages.Package{}
+	var load func(path string) (*packages.Package, error)
+	load = func(path string) (*packages.Package, error) {
+		if pkg, ok := loaded[path]; ok {
+			return pkg, nil
+		}
+		names, err := filepath.Glob(filepath.Join(analysistest.TestData(), "src", filepath.FromSlash(path), "*.go"))
+		if err != nil || len(names) == 0 {
+			return nil, fmt.Errorf("package %s not found in testdata", path)
+		}
+		pkg := &packages.Package{
+			ID: path, PkgPath: path, Fset: fset, TypesSizes: sizes, GoFiles: names,
+			Imports: map[string]*packages.Package{},
+			TypesInfo: &types.Info{
+				Types:      map[ast.Expr]types.TypeAndValue{},
+				Defs:       map[*ast.Ident]types.Object{},
+				Uses:       map[*ast.Ident]types.Object{},
+				Implicits:  map[ast.Node]types.Object{},
+				Selections: map[*ast.SelectorExpr]*types.Selection{},
+				Scopes:     map[ast.Node]*types.Scope{},
+				Instances:  map[*ast.Ident]types.Instance{},
+			},
+		}
+		for _, name := range names {
+			f, err := parser.ParseFile(fset, name, nil, parser.ParseComments|parser.SkipObjectResolution)
+			if err != nil {
+				return nil, err
+			}
+			pkg.Syntax = append(pkg.Syntax, f)
+		}
+		conf := types.Config{Sizes: sizes, Importer: importerFunc(func(path string) (*types.Package, error) {
+			dep, err := load(path)
+			if err != nil {
+				return nil, err
+			}
+			pkg.Imports[path] = dep
+			return dep.Types, nil
+		})}
+		if pkg.Types, err = conf.Check(path, fset, pkg.Syntax, pkg.TypesInfo); err != nil {
+			return nil, err
+		}
+		pkg.Name = pkg.Types.Name()
+		loaded[path] = pkg
+		return pkg, nil
+	}
+
+	var pkgs []*packages.Package
+	for _, path := range paths {
+		pkg, err := load(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+// wantRe — ожидание диагностики в комментарии, как в analysistest: // want `регулярное выражение`.
+var wantRe = regexp.MustCompile("`([^`]*)`")
+
+// checkWants сверяет диагностики с комментариями want в файлах пакета.
+func checkWants(t *testing.T, pkg *packages.Package, diags []analysis.Diagnostic) {
+	t.Helper()
+	type key struct {
+		file string
+		line int
+	}
+	want := map[key][]*regexp.Regexp{}
+	for _, f := range pkg.Syntax {
+		for _, cg := range f.Comments {
+			for _, c := range cg.List {
+				text, ok := strings.CutPrefix(strings.TrimSpace(strings.TrimPrefix(c.Text, "//")), "want ")
+				if !ok {
+					continue
+				}
+				pos := pkg.Fset.Position(c.Pos())
+				for _, m := range wantRe.FindAllStringSubmatch(text, -1) {
+					want[key{pos.Filename, pos.Line}] = append(want[key{pos.Filename, pos.Line}], regexp.MustCompile(m[1]))
+				}
+			}
+		}
+	}
+
+	for _, d := range diags {
+		pos := pkg.Fset.Position(d.Pos)
+		k := key{pos.Filename, pos.Line}
+		i := slices.IndexFunc(want[k], func(re *regexp.Regexp) bool { return re.MatchString(d.Message) })
+		if i < 0 {
+			t.Errorf("%v: unexpected diagnostic: %s", pos, d.Message)
+			continue
+		}
+		want[k] = slices.Delete(want[k], i, i+1)
+	}
+	for k, res := range want {
+		for _, re := range res {
+			t.Errorf("%s:%d: no diagnostic was reported matching `%s`", filepath.Base(k.file), k.line, re)
+		}
+	}
+}
+
+func TestInjection(t *testing.T) {
+	cfg := config.Default()
+	cfg.Taint = config.Taint{
+		Enabled:  true,
+		Sources:  []config.TaintFunc{{Package: "webx", Receiver: "Header", Methods: []string{"Get"}}, {Package: "webx", Receiver: "Request", Methods: []string{"FormValue"}}},
+		Escapers: []config.TaintFunc{{Package: "webx", Methods: []string{"Quote"}}},
+	}
+	// LOG012 на тех же вызовах предлагает ту же правку, что и LOG010; она выводится один раз.
+	cfg.InterpolationKinds = map[string]bool{"injection.Logger": true}
+	results := analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "injection")
+
+	// LOG010 ставится на операнд с недоверенным значением, а не на всё сообщение.
+	want := map[int]string{
+		10: `r.FormValue("name")`,
+		15: `name`,
+		16: `name`,
+		17: `r.Header.Get("X-Real-IP")`,
+		18: `name`,
+		24: `msg`,
+	}
+	for _, r := range results {
+		for _, d := range r.Diagnostics {
+			if !strings.HasPrefix(d.Message, string(rules.RInjection)) {
+				continue
+			}
+			pos, end := r.Pass.Fset.Position(d.Pos), r.Pass.Fset.Position(d.End)
+			src, err := os.ReadFile(pos.Filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(src[pos.Offset:end.Offset]); got != want[pos.Line] {
+				t.Errorf("%v: LOG010 range covers %q, want %q", pos, got, want[pos.Line])
+			}
+		}
+	}
+}
+
+func TestDangerousValues(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "dangerous")
+}
+
+func TestInterpolation(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "interpolation")
+}
+
+func TestStaticMessage(t *testing.T) {
+	cfg := config.Default()
+	cfg.Rules.StaticMessage = true
+	cfg.MessagePackages = []string{"msgcat"}
+	analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, cfg), "staticmsg")
+}
+
+func TestPrintfVerbs(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "printfverbs")
+}
+
+func TestPrintfArgs(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "printfargs")
+}
+
+func TestFormatAwareMessages(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "fmtaware")
+}
+
+func TestRanges(t *testing.T) {
+	results := analysistest.Run(t, analysistest.TestData(), newTestAnalyzer(t, config.Default()), "ranges")
+
+	// Диапазон выделяет недопустимую руну или совпадение с шаблоном в исходнике: с учётом
+	// escape-последовательностей, raw-строк и конкатенаций на нескольких строках.
+	type key struct {
+		line int
+		id   rules.RuleID
+	}
+	want := map[key]string{
+		{9, rules.REnglishOnly}:     `м`,
+		{9, rules.RNoEmojiSpecial}:  `м`,
+		{12, rules.RNoEmojiSpecial}: `é`,
+		{14, rules.REnglishOnly}:    `с`,
+		{14, rules.RNoEmojiSpecial}: `с`,
+		{16, rules.REnglishOnly}:    `Ж`,
+		{16, rules.RNoEmojiSpecial}: `Ж`,
+		{18, rules.RSensitive}:      `\x74oken =`,
+		{18, rules.RInterpolation}:  `"user \x74oken = " + tk`,
+		{19, rules.RLowercaseStart}: `S`,
+		{20, rules.RNoEmojiSpecial}: `🚀`,
+	}
+	for _, r := range results {
+		for _, d := range r.Diagnostics {
+			pos, end := r.Pass.Fset.Position(d.Pos), r.Pass.Fset.Position(d.End)
+			id := rules.RuleID(strings.Fields(d.Message)[0])
+			src, err := os.ReadFile(pos.Filename)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w, ok := want[key{pos.Line, id}]
+			if !ok {
+				t.Errorf("%v: unexpected %s", pos, id)
+				continue
+			}
+			if got := string(src[pos.Offset:end.Offset]); got != w {
+				t.Errorf("%v: %s range covers %q, want %q", pos, id, got, w)
+			}
+		}
+	}
+}
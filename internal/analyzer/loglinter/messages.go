@@ -0,0 +1,324 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// msgPart — часть сообщения: статический текст или динамическое выражение.
+type msgPart struct {
+	text   string   // текст статической части
+	expr   ast.Expr // выражение, из которого получена часть (nil для разделителей fmt.Sprintln)
+	static bool
+	format bool // статическая часть — printf-шаблон, директивы в ней ещё не подставлены
+}
+
+// stringFuncKind описывает, как функция собирает строку из своих аргументов.
+type stringFuncKind int
+
+const (
+	// sfFormat — первый аргумент шаблон, остальные — операнды (fmt.Sprintf, fmt.Errorf).
+	sfFormat stringFuncKind = iota
+	// sfConcat — аргументы выводятся подряд (fmt.Sprint, fmt.Sprintln).
+	sfConcat
+	// sfIdentity — строка равна единственному аргументу (errors.New).
+	sfIdentity
+	// sfJoin — элементы среза через разделитель (strings.Join).
+	sfJoin
+	// sfRune — единственный аргумент — байт или руна (strings.Builder.WriteRune).
+	sfRune
+)
+
+// stringFunc — правило разбора вызова функции, возвращающей строку.
+type stringFunc struct {
+	kind stringFuncKind
+	sep  string // разделитель между аргументами для sfConcat; без него пробелы ставятся как в fmt.Sprint
+}
+
+// stringFuncs — известные функции, производящие строки, по пути пакета и имени.
+var stringFuncs = map[[2]string]stringFunc{
+	{"fmt", "Sprintf"}:  {kind: sfFormat},
+	{"fmt", "Errorf"}:   {kind: sfFormat},
+	{"fmt", "Sprint"}:   {kind: sfConcat},
+	{"fmt", "Sprintln"}: {kind: sfConcat, sep: " "},
+	{"errors", "New"}:   {kind: sfIdentity},
+	{"strings", "Join"}: {kind: sfJoin},
+}
+
+// builderWrites — методы strings.Builder и функции fmt, дописывающие текст в Builder.
+// Значение — правило разбора аргументов после получателя/приёмника.
+var builderWrites = map[string]stringFunc{
+	"WriteString": {kind: sfIdentity},
+	"WriteByte":   {kind: sfRune},
+	"WriteRune":   {kind: sfRune},
+	"Fprintf":     {kind: sfFormat},
+	"Fprint":      {kind: sfConcat},
+	"Fprintln":    {kind: sfConcat, sep: " "},
+}
+
+// messageParts разбирает выражение сообщения на статические и динамические части.
+// Всё, что не удалось разобрать, становится одной динамической частью.
+func messageParts(pass *analysis.Pass, expr ast.Expr) []msgPart {
+	if pass == nil || pass.TypesInfo == nil {
+		return []msgPart{{expr: expr}}
+	}
+	if tv, ok := pass.TypesInfo.Types[expr]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+		return []msgPart{{text: constant.StringVal(tv.Value), expr: expr, static: true}}
+	}
+
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return messageParts(pass, e.X)
+
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			break
+		}
+		if t := pass.TypesInfo.TypeOf(e); t != nil && !isStringType(t) {
+			break
+		}
+		return append(messageParts(pass, e.X), messageParts(pass, e.Y)...)
+
+	case *ast.CallExpr:
+		if parts, ok := callParts(pass, e); ok {
+			return parts
+		}
+	}
+	return []msgPart{{expr: expr}}
+}
+
+// callParts разбирает вызов функции из stringFuncs, String() у strings.Builder
+// и Error()/String() у значений, текст которых известен (errors.New("...").Error()).
+func callParts(pass *analysis.Pass, call *ast.CallExpr) ([]msgPart, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return nil, false
+	}
+
+	if sf, ok := lookupStringFunc(pass, call); ok {
+		return argsParts(pass, sf, call.Args)
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || fn.Type().(*types.Signature).Recv() == nil || len(call.Args) != 0 || (fn.Name() != "String" && fn.Name() != "Error") {
+		return nil, false
+	}
+	if isNamedType(pass.TypesInfo.TypeOf(sel.X), "strings", "Builder") {
+		return builderParts(pass, sel.X, call)
+	}
+	parts := messageParts(pass, sel.X)
+	if len(parts) == 1 && !parts[0].static {
+		return nil, false
+	}
+	return parts, true
+}
+
+// lookupStringFunc ищет вызываемую функцию уровня пакета в таблице stringFuncs.
+func lookupStringFunc(pass *analysis.Pass, call *ast.CallExpr) (stringFunc, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Type().(*types.Signature).Recv() != nil {
+		return stringFunc{}, false
+	}
+	sf, ok := stringFuncs[[2]string{fn.Pkg().Path(), fn.Name()}]
+	return sf, ok
+}
+
+// argsParts собирает части строки из аргументов по правилу sf.
+func argsParts(pass *analysis.Pass, sf stringFunc, args []ast.Expr) ([]msgPart, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+
+	switch sf.kind {
+	case sfFormat:
+		parts := messageParts(pass, args[0])
+		for i := range parts {
+			if parts[i].static {
+				parts[i].format = true
+			}
+		}
+		for _, a := range args[1:] {
+			parts = append(parts, msgPart{expr: a})
+		}
+		return parts, true
+
+	case sfConcat:
+		var parts []msgPart
+		for i, a := range args {
+			if i > 0 && sf.sep != "" {
+				parts = append(parts, msgPart{text: sf.sep, static: true})
+			}
+			if i > 0 && sf.sep == "" {
+				// fmt.Sprint ставит пробел между операндами, если ни один из них не строка.
+				// Если тип операнда узнаётся только во время выполнения (интерфейс), между
+				// частями остаётся динамическая граница без выражения.
+				switch space, known := sprintSpace(pass, args[i-1], a); {
+				case !known:
+					parts = append(parts, msgPart{})
+				case space:
+					parts = append(parts, msgPart{text: " ", static: true})
+				}
+			}
+			if t := pass.TypesInfo.TypeOf(a); t != nil && isStringType(t) {
+				parts = append(parts, messageParts(pass, a)...)
+				continue
+			}
+			parts = append(parts, msgPart{expr: a})
+		}
+		return parts, true
+
+	case sfIdentity:
+		return messageParts(pass, args[0]), true
+
+	case sfRune:
+		if tv, ok := pass.TypesInfo.Types[args[0]]; ok && tv.Value != nil && tv.Value.Kind() == constant.Int {
+			if r, ok := constant.Int64Val(tv.Value); ok {
+				return []msgPart{{text: string(rune(r)), expr: args[0], static: true}}, true
+			}
+		}
+		return []msgPart{{expr: args[0]}}, true
+
+	case sfJoin:
+		lit, ok := ast.Unparen(args[0]).(*ast.CompositeLit)
+		if !ok || len(args) < 2 {
+			return nil, false
+		}
+		var parts []msgPart
+		for i, elt := range lit.Elts {
+			if i > 0 {
+				parts = append(parts, messageParts(pass, args[1])...)
+			}
+			parts = append(parts, messageParts(pass, elt)...)
+		}
+		return parts, len(parts) > 0
+	}
+	return nil, false
+}
+
+// sprintSpace сообщает, ставит ли fmt.Sprint пробел между соседними операндами a и b:
+// пробел добавляется, если ни один из них не строка. known == false, если это зависит
+// от динамического типа операнда-интерфейса.
+func sprintSpace(pass *analysis.Pass, a, b ast.Expr) (space, known bool) {
+	ta, tb := pass.TypesInfo.TypeOf(a), pass.TypesInfo.TypeOf(b)
+	if ta == nil || tb == nil {
+		return false, false
+	}
+	if isStringType(ta) || isStringType(tb) {
+		return false, true
+	}
+	if types.IsInterface(ta) || types.IsInterface(tb) {
+		return false, false
+	}
+	return true, true
+}
+
+// builderParts собирает текст strings.Builder из записей в него, сделанных в той же функции
+// до вызова String(). Порядок берётся по исходному коду, ветвления и циклы не учитываются.
+func builderParts(pass *analysis.Pass, builder ast.Expr, stringCall *ast.CallExpr) ([]msgPart, bool) {
+	id, ok := ast.Unparen(builder).(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	obj := pass.TypesInfo.Uses[id]
+	if obj == nil {
+		return nil, false
+	}
+	body := enclosingFuncBody(pass, stringCall)
+	if body == nil {
+		return nil, false
+	}
+
+	var parts []msgPart
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil || n.Pos() >= stringCall.Pos() {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || call.End() > stringCall.Pos() {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		sf, ok := builderWrites[sel.Sel.Name]
+		if !ok {
+			return true
+		}
+
+		args := call.Args
+		if x, ok := ast.Unparen(sel.X).(*ast.Ident); ok && pass.TypesInfo.Uses[x] == obj {
+			// b.WriteString(...)
+		} else if len(args) > 0 && refersTo(pass, args[0], obj) {
+			// fmt.Fprintf(&b, ...)
+			args = args[1:]
+		} else {
+			return true
+		}
+
+		if p, ok := argsParts(pass, sf, args); ok {
+			parts = append(parts, p...)
+		}
+		return false
+	})
+	return parts, len(parts) > 0
+}
+
+// refersTo сообщает, что expr — это obj или &obj.
+func refersTo(pass *analysis.Pass, expr ast.Expr, obj types.Object) bool {
+	expr = ast.Unparen(expr)
+	if u, ok := expr.(*ast.UnaryExpr); ok && u.Op == token.AND {
+		expr = ast.Unparen(u.X)
+	}
+	id, ok := expr.(*ast.Ident)
+	return ok && pass.TypesInfo.Uses[id] == obj
+}
+
+// enclosingFuncBody возвращает тело ближайшей функции (FuncDecl или FuncLit), содержащей node.
+func enclosingFuncBody(pass *analysis.Pass, node ast.Node) *ast.BlockStmt {
+	for _, f := range pass.Files {
+		if node.Pos() < f.FileStart || node.Pos() > f.FileEnd {
+			continue
+		}
+		path, _ := astutil.PathEnclosingInterval(f, node.Pos(), node.End())
+		for _, n := range path {
+			switch fn := n.(type) {
+			case *ast.FuncLit:
+				return fn.Body
+			case *ast.FuncDecl:
+				return fn.Body
+			}
+		}
+	}
+	return nil
+}
+
+// joinStatic склеивает статические части сообщения.
+func joinStatic(parts []msgPart) (string, bool) {
+	var b strings.Builder
+	found := false
+	for _, p := range parts {
+		if p.static {
+			b.WriteString(p.text)
+			found = true
+		}
+	}
+	return b.String(), found
+}
+
+// hasDynamicPart сообщает, что среди частей есть динамическая.
+func hasDynamicPart(parts []msgPart) bool {
+	for _, p := range parts {
+		if !p.static {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/iconfire7/loglintergo/internal/printf"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+)
+
+// textPiece — статическая часть сообщения в проверяемом тексте.
+type textPiece struct {
+	start, end int // диапазон части в проверяемом тексте
+	part       msgPart
+}
+
+// checkedText строит текст, к которому применяются правила, — статические части сообщения, —
+// и маску директив printf-шаблонов в нём (см. printf.Mask).
+// Длина текста совпадает с site.text, поэтому смещения нарушений относятся и к нему.
+func checkedText(pass *analysis.Pass, site msgSite) (string, rules.Mask, []textPiece) {
+	var (
+		text   []byte
+		mask   rules.Mask
+		pieces []textPiece
+	)
+	for _, p := range messageParts(pass, site.expr) {
+		if !p.static {
+			continue
+		}
+		pieces = append(pieces, textPiece{start: len(text), end: len(text) + len(p.text), part: p})
+		text = append(text, p.text...)
+		if p.format {
+			mask = append(mask, printf.Mask(p.text)...)
+		} else {
+			mask = append(mask, make([]bool, len(p.text))...)
+		}
+	}
+	if len(text) != len(site.text) {
+		// Текст сайта получен не из site.expr (например, из значения константы): проверяем его целиком.
+		text, mask, pieces = []byte(site.text), nil, nil
+	}
+	if site.printf {
+		// Сообщение printf-метода целиком является шаблоном.
+		mask = printf.Mask(string(text))
+	}
+	return string(text), mask, pieces
+}
+
+// violationRange возвращает диапазон нарушения в исходнике. Начало и конец отображаются через
+// части сообщения, в которые они попадают (строковые литералы, их конкатенации и константы
+// пакета), поэтому диапазон может охватывать несколько строк. Если отобразить их не удалось
+// или нарушение относится ко всему сообщению, возвращается диапазон сайта.
+func violationRange(pass *analysis.Pass, site msgSite, pieces []textPiece, v rules.Violation) (token.Pos, token.Pos) {
+	if v.End <= v.Start {
+		return site.pos, site.end
+	}
+	start, ok1 := sourcePos(pass, pieces, v.Start, false)
+	end, ok2 := sourcePos(pass, pieces, v.End-1, true)
+	if !ok1 || !ok2 || end <= start {
+		return site.pos, site.end
+	}
+	return start, end
+}
+
+// sourcePos возвращает начало (или конец, если end) символа исходника, из которого получен
+// байт i проверяемого текста.
+func sourcePos(pass *analysis.Pass, pieces []textPiece, i int, end bool) (token.Pos, bool) {
+	for _, pc := range pieces {
+		if i < pc.start || i >= pc.end {
+			continue
+		}
+		spans, ok := sourceSpans(pass, pc.part.expr)
+		if !ok || len(spans) != pc.end-pc.start {
+			return token.NoPos, false
+		}
+		if end {
+			return spans[i-pc.start].end, true
+		}
+		return spans[i-pc.start].pos, true
+	}
+	return token.NoPos, false
+}
+
+// srcSpan — символ исходника, из которого получен байт значения строки.
+type srcSpan struct{ pos, end token.Pos }
+
+// sourceSpans сопоставляет каждому байту значения строкового выражения символ исходника
+// по литералам, из которых оно составлено (см. stringLeaves).
+func sourceSpans(pass *analysis.Pass, expr ast.Expr) ([]srcSpan, bool) {
+	leaves, ok := stringLeaves(pass, expr)
+	if !ok {
+		return nil, false
+	}
+	var spans []srcSpan
+	for _, lit := range leaves {
+		if lit.Kind == token.CHAR {
+			// Руна-литерал в конкатенации: "done" + string('!').
+			r, _, _, err := strconv.UnquoteChar(lit.Value[1:len(lit.Value)-1], '\'')
+			if err != nil {
+				return nil, false
+			}
+			for range utf8.RuneLen(r) {
+				spans = append(spans, srcSpan{lit.Pos(), lit.End()})
+			}
+			continue
+		}
+		ls, ok := literalSpans(lit)
+		if !ok {
+			return nil, false
+		}
+		spans = append(spans, ls...)
+	}
+	return spans, true
+}
+
+// stringLeaves раскладывает строковое выражение на литералы, из которых оно составлено:
+// конкатенации "a" + "b", преобразования string('!') и Msg("...") и константы пакета
+// (через выражение их значения). Руны-литералы возвращаются с Kind == token.CHAR.
+func stringLeaves(pass *analysis.Pass, expr ast.Expr) ([]*ast.BasicLit, bool) {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING && e.Kind != token.CHAR {
+			return nil, false
+		}
+		return []*ast.BasicLit{e}, true
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return nil, false
+		}
+		x, ok := stringLeaves(pass, e.X)
+		if !ok {
+			return nil, false
+		}
+		y, ok := stringLeaves(pass, e.Y)
+		if !ok {
+			return nil, false
+		}
+		return append(x, y...), true
+	case *ast.CallExpr:
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return stringLeaves(pass, e.Args[0])
+		}
+	}
+	if _, value := messageConst(pass, expr); value != nil {
+		return stringLeaves(pass, value)
+	}
+	return nil, false
+}
+
+// literalSpans сопоставляет каждому байту значения строкового литерала символ исходника.
+// Байты руны, записанной escape-последовательностью или несколькими байтами UTF-8, получают
+// диапазон всего символа; удаляемые из raw-строк символы '\r' пропускаются.
+func literalSpans(lit *ast.BasicLit) ([]srcSpan, bool) {
+	src := lit.Value
+	if len(src) < 2 {
+		return nil, false
+	}
+	at := func(off int) token.Pos { return lit.Pos() + token.Pos(off) }
+
+	var spans []srcSpan
+	if src[0] == '`' {
+		for i := 1; i < len(src)-1; {
+			if src[i] == '\r' {
+				i++
+				continue
+			}
+			_, n := utf8.DecodeRuneInString(src[i : len(src)-1])
+			for range n {
+				spans = append(spans, srcSpan{at(i), at(i + n)})
+			}
+			i += n
+		}
+		return spans, true
+	}
+
+	body := src[1 : len(src)-1]
+	for rest := body; len(rest) > 0; {
+		off := 1 + len(body) - len(rest)
+		r, multibyte, tail, err := strconv.UnquoteChar(rest, src[0])
+		if err != nil {
+			return nil, false
+		}
+		n := 1
+		if r >= utf8.RuneSelf && multibyte {
+			n = utf8.RuneLen(r)
+		}
+		for range n {
+			spans = append(spans, srcSpan{at(off), at(off + len(rest) - len(tail))})
+		}
+		rest = tail
+	}
+	return spans, true
+}
@@ -0,0 +1,243 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/iconfire7/loglintergo/internal/printf"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// checkPrintfArgs сверяет printf-шаблон метода логгера (Infof у zap sugar, log.Printf, Errorf
+// у logrus, printf-обёртки из loggers и найденные автоматически) с аргументами, как это делает
+// go vet printf: число аргументов, типы операндов, %w и %s с ошибкой, которая может быть nil.
+func (l *linter) checkPrintfArgs(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr, report func(analysis.Diagnostic)) {
+	if !lc.printf || call.Ellipsis.IsValid() {
+		return
+	}
+	parts := messageParts(pass, msgExpr)
+	format, ok := joinStatic(parts)
+	if !ok || hasDynamicPart(parts) {
+		return
+	}
+	args := call.Args[lc.msgIdx+1:]
+
+	diag := func(node ast.Node, msg string) {
+		report(analysis.Diagnostic{
+			Pos:     node.Pos(),
+			End:     node.End(),
+			Message: string(rules.RPrintfArgs) + " " + msg + " (" + lc.kind + ")",
+		})
+	}
+
+	dirs := printf.Directives(format)
+	indexed := false
+	for _, d := range dirs {
+		indexed = indexed || d.Indexed
+		switch {
+		case d.Err != "":
+			diag(msgExpr, "bad printf directive "+strconv.Quote(d.Text)+": "+d.Err)
+			continue
+		case d.Verb == 'w':
+			diag(msgExpr, "printf directive "+d.Text+" is only supported by fmt.Errorf; use %v")
+			continue
+		case !printf.KnownVerb(d.Verb):
+			diag(msgExpr, "unknown printf verb "+strconv.QuoteRune(d.Verb)+" in "+d.Text)
+			continue
+		}
+
+		for _, i := range []int{d.WidthArg, d.PrecArg} {
+			if i >= 0 && i < len(args) && !isIntArg(pass, args[i]) {
+				diag(args[i], "printf directive "+d.Text+" uses non-int "+types.ExprString(args[i])+" as width or precision")
+			}
+		}
+		if d.Arg >= len(args) {
+			diag(msgExpr, "printf directive "+d.Text+" has no argument: format reads "+
+				strconv.Itoa(printf.ArgCount(dirs))+" args, but call has "+strconv.Itoa(len(args)))
+			continue
+		}
+		arg := args[d.Arg]
+		if t := pass.TypesInfo.TypeOf(arg); t != nil && !verbAccepts(d.Verb, t) {
+			diag(arg, "printf directive "+d.Text+" has argument "+types.ExprString(arg)+
+				" of wrong type "+types.TypeString(t, types.RelativeTo(pass.Pkg)))
+			continue
+		}
+		if (d.Verb == 's' || d.Verb == 'q') && maybeNilError(pass, call, arg) {
+			diag(arg, "error "+types.ExprString(arg)+" may be nil here and is printed as %!"+string(d.Verb)+"(<nil>); check it for nil or use %v")
+		}
+	}
+
+	if n := printf.ArgCount(dirs); !indexed && n < len(args) {
+		diag(args[n], "printf call has "+strconv.Itoa(len(args))+" args, but format reads "+strconv.Itoa(n))
+	}
+}
+
+var (
+	errorType    = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+	stringerType = types.NewInterfaceType([]*types.Func{
+		types.NewFunc(token.NoPos, nil, "String", types.NewSignatureType(nil, nil, nil, nil,
+			types.NewTuple(types.NewVar(token.NoPos, nil, "", types.Typ[types.String])), false)),
+	}, nil).Complete()
+)
+
+// verbAccepts сообщает, что значение типа t можно вывести глаголом verb. Как и в fmt,
+// для %v, %s, %q, %x и %X используются методы Error и String, а тип с методом Format
+// принимает любой глагол. Интерфейсы и составные типы (структуры, срезы, отображения)
+// не проверяются: fmt применяет глагол к их элементам.
+func verbAccepts(verb rune, t types.Type) bool {
+	if verb == 'v' || verb == 'T' {
+		return true
+	}
+	if types.IsInterface(t) || hasMethod(t, "Format") {
+		return true
+	}
+	if strings.ContainsRune("sqxX", verb) && (types.Implements(t, errorType) || types.Implements(t, stringerType)) {
+		return true
+	}
+
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		info := u.Info()
+		switch verb {
+		case 'd', 'o', 'O', 'c', 'U':
+			return info&types.IsInteger != 0
+		case 'b':
+			return info&(types.IsInteger|types.IsFloat|types.IsComplex) != 0
+		case 'x', 'X':
+			return info&(types.IsInteger|types.IsFloat|types.IsComplex|types.IsString) != 0
+		case 'e', 'E', 'f', 'F', 'g', 'G':
+			return info&(types.IsFloat|types.IsComplex) != 0
+		case 's':
+			return info&types.IsString != 0
+		case 'q':
+			return info&(types.IsString|types.IsInteger) != 0
+		case 't':
+			return info&types.IsBoolean != 0
+		case 'p':
+			return u.Kind() == types.UnsafePointer
+		}
+		return true
+	case *types.Pointer, *types.Chan, *types.Signature:
+		// Указатель на структуру, массив, срез или отображение fmt выводит как &{...} для любого глагола.
+		if p, ok := u.(*types.Pointer); ok && verb != 'p' {
+			switch p.Elem().Underlying().(type) {
+			case *types.Struct, *types.Array, *types.Slice, *types.Map:
+				return true
+			}
+		}
+		return verb == 'p' || strings.ContainsRune("bdoOxX", verb)
+	}
+	return true
+}
+
+// isIntArg сообщает, что аргумент — целое число (для '*' в ширине и точности).
+func isIntArg(pass *analysis.Pass, arg ast.Expr) bool {
+	t := pass.TypesInfo.TypeOf(arg)
+	if t == nil || types.IsInterface(t) {
+		return true
+	}
+	b, ok := t.Underlying().(*types.Basic)
+	return ok && b.Info()&types.IsInteger != 0
+}
+
+// maybeNilError сообщает, что аргумент — локальная переменная или параметр типа error, который
+// не проверен на nil перед вызовом: вызов не находится в ветке if err != nil (или else у
+// if err == nil), и ему не предшествует if err == nil с выходом из блока.
+func maybeNilError(pass *analysis.Pass, call *ast.CallExpr, arg ast.Expr) bool {
+	id, ok := ast.Unparen(arg).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	v, ok := pass.TypesInfo.Uses[id].(*types.Var)
+	if !ok || !types.Identical(v.Type(), types.Universe.Lookup("error").Type()) {
+		return false
+	}
+
+	var path []ast.Node
+	for _, f := range pass.Files {
+		if f.FileStart <= call.Pos() && call.Pos() <= f.FileEnd {
+			path, _ = astutil.PathEnclosingInterval(f, call.Pos(), call.End())
+			break
+		}
+	}
+	if path == nil {
+		return false
+	}
+	for i := 1; i < len(path); i++ {
+		child := path[i-1]
+		switch n := path[i].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return true
+		case *ast.IfStmt:
+			if child == n.Body && nilCheck(pass, n.Cond, v, token.NEQ) {
+				return false
+			}
+			if child == n.Else && nilCheck(pass, n.Cond, v, token.EQL) {
+				return false
+			}
+		case *ast.CaseClause:
+			for _, e := range n.List {
+				if nilCheck(pass, e, v, token.NEQ) {
+					return false
+				}
+			}
+		case *ast.BlockStmt:
+			for _, stmt := range n.List {
+				if stmt == child {
+					break
+				}
+				if is, ok := stmt.(*ast.IfStmt); ok && nilCheck(pass, is.Cond, v, token.EQL) && terminates(is.Body) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// nilCheck сообщает, что условие cond (или одно из условий, соединённых && для != и || для ==)
+// сравнивает v с nil оператором op.
+func nilCheck(pass *analysis.Pass, cond ast.Expr, v *types.Var, op token.Token) bool {
+	b, ok := ast.Unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	if b.Op == token.LAND && op == token.NEQ {
+		return nilCheck(pass, b.X, v, op) || nilCheck(pass, b.Y, v, op)
+	}
+	if b.Op == token.LOR && op == token.EQL {
+		return nilCheck(pass, b.X, v, op) || nilCheck(pass, b.Y, v, op)
+	}
+	if b.Op != op {
+		return false
+	}
+	isNil := func(e ast.Expr) bool { return pass.TypesInfo.Types[e].IsNil() }
+	isVar := func(e ast.Expr) bool {
+		id, ok := ast.Unparen(e).(*ast.Ident)
+		return ok && pass.TypesInfo.Uses[id] == v
+	}
+	return isVar(b.X) && isNil(b.Y) || isNil(b.X) && isVar(b.Y)
+}
+
+// terminates сообщает, что блок заканчивается выходом: return, panic, continue, break или goto.
+func terminates(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return false
+	}
+	switch s := body.List[len(body.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		if call, ok := s.X.(*ast.CallExpr); ok {
+			if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "panic" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package loglinter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// leakFact помечает метод представления в логе, который выводит чувствительное поле.
+type leakFact struct {
+	Field string // поле, которое попадает в вывод метода (Password, DB.Password)
+}
+
+func (*leakFact) AFact() {}
+
+func (f *leakFact) String() string {
+	return fmt.Sprintf("leaks sensitive field %s", f.Field)
+}
+
+// redactionMethods — методы, через которые тип задаёт своё представление в логе.
+var redactionMethods = []string{"LogValue", "MarshalLogObject", "String", "Error"}
+
+// redactionMethod возвращает метод представления типа в логе (LogValue, MarshalLogObject, String, Error).
+func redactionMethod(t types.Type) *types.Func {
+	ms := types.NewMethodSet(t)
+	for _, name := range redactionMethods {
+		sel := ms.Lookup(nil, name)
+		if sel == nil {
+			continue
+		}
+		if fn, ok := sel.Obj().(*types.Func); ok && isRedactionMethod(fn) {
+			return fn
+		}
+	}
+	return nil
+}
+
+// isRedactionMethod проверяет сигнатуру метода представления.
+func isRedactionMethod(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	switch fn.Name() {
+	case "LogValue":
+		return sig.Params().Len() == 0 && sig.Results().Len() == 1 && isNamedType(sig.Results().At(0).Type(), slogPath, "Value")
+	case "MarshalLogObject":
+		return sig.Params().Len() == 1 && sig.Results().Len() == 1
+	case "String", "Error":
+		return sig.Params().Len() == 0 && sig.Results().Len() == 1 && isStringType(sig.Results().At(0).Type())
+	}
+	return false
+}
+
+// exportLeakFacts экспортирует leakFact для методов представления, выводящих чувствительные поля.
+func (l *linter) exportLeakFacts(pass *analysis.Pass, report func(analysis.Diagnostic)) {
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Body == nil {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok || !isRedactionMethod(fn) {
+				continue
+			}
+
+			var fact *leakFact
+			for _, leak := range l.methodLeaks(pass, fd) {
+				report(analysis.Diagnostic{
+					Pos:     leak.Pos(),
+					End:     leak.End(),
+					Message: string(rules.RRedactionLeak) + " " + fn.Name() + " emits sensitive field " + types.ExprString(leak),
+				})
+				if fact == nil {
+					fact = &leakFact{Field: fieldPath(pass, leak)}
+				}
+			}
+			if fact != nil {
+				pass.ExportObjectFact(fn, fact)
+			}
+		}
+	}
+}
+
+// methodLeaks находит чтения чувствительных полей, которые попадают в вывод метода.
+func (l *linter) methodLeaks(pass *analysis.Pass, fd *ast.FuncDecl) []*ast.SelectorExpr {
+	var leaks []*ast.SelectorExpr
+	seen := map[token.Pos]bool{}
+	add := func(sel *ast.SelectorExpr) {
+		if sel != nil && !seen[sel.Pos()] {
+			seen[sel.Pos()] = true
+			leaks = append(leaks, sel)
+		}
+	}
+
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			for _, r := range n.Results {
+				add(l.emittedSecret(pass, r))
+			}
+		case *ast.CallExpr:
+			if isEmitSink(pass, n) {
+				for _, a := range n.Args {
+					add(l.emittedSecret(pass, a))
+				}
+			}
+		}
+		return true
+	})
+	return leaks
+}
+
+// emittedSecret ищет чувствительное поле, значение которого попадает в результат выражения.
+func (l *linter) emittedSecret(pass *analysis.Pass, e ast.Expr) *ast.SelectorExpr {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.SelectorExpr:
+		if l.isSecretSelection(pass, e) {
+			return e
+		}
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return nil
+		}
+		if s := l.emittedSecret(pass, e.X); s != nil {
+			return s
+		}
+		return l.emittedSecret(pass, e.Y)
+	case *ast.UnaryExpr:
+		if e.Op == token.AND {
+			return l.emittedSecret(pass, e.X)
+		}
+	case *ast.CompositeLit:
+		for _, elt := range e.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+			if s := l.emittedSecret(pass, elt); s != nil {
+				return s
+			}
+		}
+	case *ast.CallExpr:
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return l.emittedSecret(pass, e.Args[0])
+		}
+		if !isEmitSink(pass, e) && !isAppend(pass, e) {
+			if _, ok := lookupStringFunc(pass, e); !ok {
+				return nil
+			}
+		}
+		for _, a := range e.Args {
+			if s := l.emittedSecret(pass, a); s != nil {
+				return s
+			}
+		}
+	}
+	return nil
+}
+
+// isEmitSink проверяет, что вызов выводит аргументы в запись лога (slog, zap, zapcore.ObjectEncoder).
+func isEmitSink(pass *analysis.Pass, call *ast.CallExpr) bool {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return false
+	}
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		return isNamedType(recv.Type(), zapcorePath, "ObjectEncoder")
+	}
+	switch fn.Pkg().Path() {
+	case slogPath, zapPath:
+		return true
+	}
+	return false
+}
+
+func isAppend(pass *analysis.Pass, call *ast.CallExpr) bool {
+	id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	b, ok := pass.TypesInfo.Uses[id].(*types.Builtin)
+	return ok && b.Name() == "append"
+}
+
+// isSecretSelection сообщает, что выражение читает поле, которое выглядит чувствительным.
+func (l *linter) isSecretSelection(pass *analysis.Pass, sel *ast.SelectorExpr) bool {
+	f, tag, ok := selectedField(pass, sel)
+	return ok && l.isSecretField(f, tag)
+}
+
+// selectedField возвращает поле, выбранное выражением sel, и его тег (с учётом встроенных полей).
+func selectedField(pass *analysis.Pass, sel *ast.SelectorExpr) (*types.Var, string, bool) {
+	s, ok := pass.TypesInfo.Selections[sel]
+	if !ok || s.Kind() != types.FieldVal {
+		return nil, "", false
+	}
+	t := s.Recv()
+	var (
+		field *types.Var
+		tag   string
+	)
+	for _, idx := range s.Index() {
+		if p, ok := types.Unalias(t).Underlying().(*types.Pointer); ok {
+			t = p.Elem()
+		}
+		st, ok := types.Unalias(t).Underlying().(*types.Struct)
+		if !ok {
+			return nil, "", false
+		}
+		field, tag = st.Field(idx), st.Tag(idx)
+		t = field.Type()
+	}
+	return field, tag, field != nil
+}
+
+// fieldPath возвращает путь к полю от получателя метода (p.DB.Password -> DB.Password).
+func fieldPath(pass *analysis.Pass, sel *ast.SelectorExpr) string {
+	path := sel.Sel.Name
+	for x := ast.Unparen(sel.X); ; {
+		inner, ok := x.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		if _, ok := pass.TypesInfo.Selections[inner]; !ok {
+			break
+		}
+		path = inner.Sel.Name + "." + path
+		x = ast.Unparen(inner.X)
+	}
+	return path
+}
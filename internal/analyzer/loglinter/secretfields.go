@@ -0,0 +1,131 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+)
+
+// redactTag — тег поля, которым помечают данные, не предназначенные для логов.
+const redactTag = "redact"
+
+// loggedValues возвращает выражения, значения которых попадают в запись лога.
+func loggedValues(pass *analysis.Pass, d *detector, call *ast.CallExpr) ([]ast.Expr, string) {
+	var values []ast.Expr
+	if lc, ok := d.detectLoggerCall(pass, call); ok {
+		msgExpr, hasMsg := lc.msgArg(call)
+		for _, arg := range call.Args {
+			if hasMsg && arg == msgExpr {
+				for _, p := range messageParts(pass, arg) {
+					if !p.static && p.expr != nil {
+						values = append(values, p.expr)
+					}
+				}
+				continue
+			}
+			values = append(values, arg)
+		}
+		return values, lc.kind
+	}
+
+	// Значения атрибутов: slog.Any("cfg", cfg), zap.Any(...), .Interface(...) zerolog, With(...).
+	attrs, kind := callAttrs(pass, d, call)
+	for _, a := range attrs {
+		if a.value != nil {
+			values = append(values, a.value)
+		}
+	}
+	return values, kind
+}
+
+// checkSecretFields ищет в логируемых значениях чувствительные поля (LOG007, LOG008).
+func (l *linter) checkSecretFields(pass *analysis.Pass, call *ast.CallExpr, report func(analysis.Diagnostic)) {
+	if !l.cfg.Rules.SecretFields && !l.cfg.Rules.RedactionLeaks {
+		return
+	}
+	values, kind := loggedValues(pass, l.detector, call)
+	for _, v := range values {
+		t := pass.TypesInfo.TypeOf(v)
+		if t == nil || isAttrValue(t) {
+			continue
+		}
+		path, via, ok := l.secretFieldPath(pass, t, map[types.Type]bool{})
+		if !ok {
+			continue
+		}
+		typ := types.TypeString(t, types.RelativeTo(pass.Pkg))
+		var msg string
+		switch {
+		case via == "" && l.cfg.Rules.SecretFields:
+			msg = string(rules.RSecretFields) + " logged value of type " + typ + " exposes sensitive field " + path
+		case via != "" && l.cfg.Rules.RedactionLeaks:
+			msg = string(rules.RRedactionLeak) + " logged value of type " + typ + " leaks sensitive field " + path +
+				" through its " + via + " method"
+		default:
+			continue
+		}
+		report(analysis.Diagnostic{Pos: v.Pos(), End: v.End(), Message: msg + " (" + kind + ")"})
+	}
+}
+
+// secretFieldPath возвращает путь к чувствительному полю типа t (DB.Password) и метод, который его выводит.
+func (l *linter) secretFieldPath(pass *analysis.Pass, t types.Type, seen map[types.Type]bool) (path, via string, ok bool) {
+	if seen[t] {
+		return "", "", false
+	}
+	seen[t] = true
+
+	if m := redactionMethod(t); m != nil {
+		var fact leakFact
+		if pass.ImportObjectFact(m.Origin(), &fact) {
+			return fact.Field, m.Name(), true
+		}
+		return "", "", false
+	}
+
+	switch u := types.Unalias(t).Underlying().(type) {
+	case *types.Pointer:
+		return l.secretFieldPath(pass, u.Elem(), seen)
+	case *types.Slice:
+		return l.secretFieldPath(pass, u.Elem(), seen)
+	case *types.Array:
+		return l.secretFieldPath(pass, u.Elem(), seen)
+	case *types.Map:
+		return l.secretFieldPath(pass, u.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < u.NumFields(); i++ {
+			f := u.Field(i)
+			if l.isSecretField(f, u.Tag(i)) {
+				return f.Name(), "", true
+			}
+			if path, via, ok := l.secretFieldPath(pass, f.Type(), seen); ok {
+				return f.Name() + "." + path, via, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// isSecretField проверяет, что поле помечено тегом log:"redact" или его имя выглядит чувствительным.
+func (l *linter) isSecretField(f *types.Var, tag string) bool {
+	st := reflect.StructTag(tag)
+	if st.Get("log") == redactTag {
+		return true
+	}
+	if b, ok := f.Type().Underlying().(*types.Basic); ok && b.Kind() == types.Bool {
+		return false
+	}
+	if _, ok := rules.SensitiveKey(f.Name(), l.cfg.SensitiveKeys); ok {
+		return true
+	}
+	if name, _, _ := strings.Cut(st.Get("json"), ","); name != "" && name != "-" {
+		if _, ok := rules.SensitiveKey(name, l.cfg.SensitiveKeys); ok {
+			return true
+		}
+	}
+	return false
+}
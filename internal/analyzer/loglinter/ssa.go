@@ -0,0 +1,338 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ssa"
+)
+
+// ssaSites находит значения, которые доходят до сообщения-переменной (msg := "..."; slog.Info(msg)),
+// и возвращает по msgSite на каждое присваивание, которое их производит. Набор значений строится
+// по SSA-форме функции: константы и φ-узлы разворачиваются, а для частично известных значений
+// (msg := "token=" + tk) проверяется выражение присваивания так же, как аргумент вызова.
+func ssaSites(pass *analysis.Pass, info *buildssa.SSA, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr) []msgSite {
+	id, ok := ast.Unparen(msgExpr).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	v, ok := pass.TypesInfo.Uses[id].(*types.Var)
+	if !ok || v.Parent() == nil || v.Parent() == v.Pkg().Scope() {
+		return nil
+	}
+
+	arg := ssaCallArg(info, call, lc)
+	if arg == nil {
+		return nil
+	}
+	body := enclosingFuncBody(pass, call)
+	if body == nil {
+		return nil
+	}
+	assigns := varAssignments(pass, body, v)
+
+	var (
+		sites []msgSite
+		seen  = map[ast.Expr]bool{}
+	)
+	for _, leaf := range ssaLeaves(arg, map[ssa.Value]bool{}) {
+		for _, rhs := range assignmentsFor(pass, assigns, leaf, call.Pos()) {
+			if seen[rhs] {
+				continue
+			}
+			seen[rhs] = true
+
+			text, ok := extractStaticText(pass, rhs)
+			if !ok {
+				continue
+			}
+			sites = append(sites, msgSite{
+				expr: rhs,
+				text: text,
+				pos:  rhs.Pos(),
+				end:  rhs.End(),
+				related: []analysis.RelatedInformation{{
+					Pos:     msgExpr.Pos(),
+					End:     msgExpr.End(),
+					Message: "value of " + v.Name() + " is used as log message here",
+				}},
+				wholePos: rhs.Pos(),
+				wholeEnd: rhs.End(),
+			})
+		}
+	}
+	return sites
+}
+
+// ssaCallArg возвращает SSA-значение аргумента-сообщения вызова логгера.
+func ssaCallArg(info *buildssa.SSA, call *ast.CallExpr, lc loggerCall) ssa.Value {
+	args := ssaCallArgs(info, call)
+	if lc.msgIdx < 0 || lc.msgIdx >= len(args) {
+		return nil
+	}
+	return args[lc.msgIdx]
+}
+
+// ssaCallArgs возвращает SSA-значения аргументов вызова в порядке call.Args. Получатель метода
+// не входит в результат, а элементы вариативного параметра извлекаются из среза, который
+// собирает для них SSA; неизвестные значения остаются nil.
+func ssaCallArgs(info *buildssa.SSA, call *ast.CallExpr) []ssa.Value {
+	common := ssaCallCommon(info, call)
+	if common == nil {
+		return nil
+	}
+	args := common.Args
+	// У статического вызова метода получатель передаётся первым аргументом.
+	if !common.IsInvoke() && common.Signature().Recv() != nil && len(args) > 0 {
+		args = args[1:]
+	}
+
+	out := make([]ssa.Value, len(call.Args))
+	sig := common.Signature()
+	if !sig.Variadic() || call.Ellipsis.IsValid() {
+		copy(out, args)
+		return out
+	}
+	fixed := sig.Params().Len() - 1
+	if fixed > len(args) || fixed > len(out) {
+		return out
+	}
+	copy(out, args[:fixed])
+	if fixed == len(args) {
+		return out
+	}
+	slice, ok := args[fixed].(*ssa.Slice)
+	if !ok {
+		return out
+	}
+	alloc, ok := slice.X.(*ssa.Alloc)
+	if !ok {
+		return out
+	}
+	for _, ref := range *alloc.Referrers() {
+		ia, ok := ref.(*ssa.IndexAddr)
+		if !ok {
+			continue
+		}
+		c, ok := ia.Index.(*ssa.Const)
+		if !ok || c.Value == nil {
+			continue
+		}
+		k, ok := constant.Int64Val(c.Value)
+		if !ok || fixed+int(k) >= len(out) {
+			continue
+		}
+		for _, r := range *ia.Referrers() {
+			if st, ok := r.(*ssa.Store); ok && st.Addr == ia {
+				out[fixed+int(k)] = st.Val
+			}
+		}
+	}
+	return out
+}
+
+// ssaCallCommon находит SSA-инструкцию вызова call.
+func ssaCallCommon(info *buildssa.SSA, call *ast.CallExpr) *ssa.CallCommon {
+	fn := enclosingSSAFunc(info, call)
+	if fn == nil {
+		return nil
+	}
+	for _, b := range fn.Blocks {
+		for _, instr := range b.Instrs {
+			if ci, ok := instr.(ssa.CallInstruction); ok && ci.Common().Pos() == call.Lparen {
+				return ci.Common()
+			}
+		}
+	}
+	return nil
+}
+
+// enclosingSSAFunc возвращает самую вложенную SSA-функцию, в исходнике которой находится node.
+func enclosingSSAFunc(info *buildssa.SSA, node ast.Node) *ssa.Function {
+	var best *ssa.Function
+	var visit func(fn *ssa.Function)
+	visit = func(fn *ssa.Function) {
+		syntax := fn.Syntax()
+		if syntax == nil || node.Pos() < syntax.Pos() || node.End() > syntax.End() {
+			return
+		}
+		best = fn
+		for _, anon := range fn.AnonFuncs {
+			visit(anon)
+		}
+	}
+	for _, fn := range info.SrcFuncs {
+		visit(fn)
+	}
+	return best
+}
+
+// ssaLeaves разворачивает φ-узлы, преобразования типов и упаковку в интерфейс и возвращает
+// значения-источники.
+func ssaLeaves(v ssa.Value, seen map[ssa.Value]bool) []ssa.Value {
+	if seen[v] {
+		return nil
+	}
+	seen[v] = true
+
+	switch v := v.(type) {
+	case *ssa.Phi:
+		var out []ssa.Value
+		for _, e := range v.Edges {
+			out = append(out, ssaLeaves(e, seen)...)
+		}
+		return out
+	case *ssa.ChangeType:
+		return ssaLeaves(v.X, seen)
+	case *ssa.Convert:
+		return ssaLeaves(v.X, seen)
+	case *ssa.MakeInterface:
+		// Сообщение в вариативном параметре ...any (Info(args ...any) у zap sugar и logrus).
+		return ssaLeaves(v.X, seen)
+	default:
+		return []ssa.Value{v}
+	}
+}
+
+// varAssignments собирает правые части присваиваний переменной v внутри body.
+func varAssignments(pass *analysis.Pass, body *ast.BlockStmt, v *types.Var) []ast.Expr {
+	var out []ast.Expr
+	is := func(e ast.Expr) bool {
+		id, ok := e.(*ast.Ident)
+		return ok && (pass.TypesInfo.Defs[id] == v || pass.TypesInfo.Uses[id] == v)
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok != token.ASSIGN && n.Tok != token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if is(lhs) {
+					out = append(out, n.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) != len(n.Values) {
+				return true
+			}
+			for i, name := range n.Names {
+				if is(name) {
+					out = append(out, n.Values[i])
+				}
+			}
+		}
+		return true
+	})
+	return out
+}
+
+// assignmentsFor сопоставляет SSA-значение с правыми частями присваиваний: константу — по её
+// значению, вычисленное значение — по позиции инструкции внутри выражения. У константы в SSA
+// нет позиции, поэтому из присваиваний с тем же текстом отбрасываются те, которые до вызова
+// в позиции use наверняка перезаписаны (см. overwritten).
+func assignmentsFor(pass *analysis.Pass, assigns []ast.Expr, leaf ssa.Value, use token.Pos) []ast.Expr {
+	var out []ast.Expr
+	if c, ok := leaf.(*ssa.Const); ok {
+		if c.Value == nil || c.Value.Kind() != constant.String {
+			return nil
+		}
+		want := constant.StringVal(c.Value)
+		for _, rhs := range assigns {
+			parts := messageParts(pass, rhs)
+			if text, ok := joinStatic(parts); ok && !hasDynamicPart(parts) && text == want {
+				out = append(out, rhs)
+			}
+		}
+		if len(out) > 1 {
+			out = slices.DeleteFunc(out, func(rhs ast.Expr) bool {
+				return overwritten(pass, rhs, assigns, use) || afterUse(pass, rhs, use)
+			})
+		}
+		return out
+	}
+
+	pos := leaf.Pos()
+	if !pos.IsValid() {
+		return nil
+	}
+	for _, rhs := range assigns {
+		if rhs.Pos() <= pos && pos < rhs.End() {
+			out = append(out, rhs)
+		}
+	}
+	return out
+}
+
+// overwritten сообщает, что значение присваивания rhs перезаписывается на любом пути до use:
+// между ними есть другое присваивание той же переменной, которое стоит отдельной инструкцией
+// в блоке, содержащем use (m := "a"; m = "b"; log(m)). Присваивания в ветках и циклах
+// значение не перезаписывают.
+func overwritten(pass *analysis.Pass, rhs ast.Expr, assigns []ast.Expr, use token.Pos) bool {
+	if rhs.Pos() >= use {
+		return false
+	}
+	for _, other := range assigns {
+		if other == rhs || other.Pos() <= rhs.End() || other.Pos() >= use {
+			continue
+		}
+		if block := assignmentBlock(pass, other); block != nil && block.Pos() <= use && use < block.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// afterUse сообщает, что присваивание rhs стоит после use и не может до него дойти: вызов и
+// присваивание не лежат в одном цикле.
+func afterUse(pass *analysis.Pass, rhs ast.Expr, use token.Pos) bool {
+	if rhs.Pos() < use {
+		return false
+	}
+	for _, f := range pass.Files {
+		if rhs.Pos() < f.FileStart || rhs.Pos() > f.FileEnd {
+			continue
+		}
+		path, _ := astutil.PathEnclosingInterval(f, rhs.Pos(), rhs.End())
+		for _, n := range path {
+			switch n.(type) {
+			case *ast.ForStmt, *ast.RangeStmt:
+				if n.Pos() <= use {
+					return false
+				}
+			case *ast.FuncLit, *ast.FuncDecl:
+				return true
+			}
+		}
+	}
+	return true
+}
+
+// assignmentBlock возвращает блок, непосредственной инструкцией которого является
+// присваивание (или объявление var) с правой частью rhs.
+func assignmentBlock(pass *analysis.Pass, rhs ast.Expr) *ast.BlockStmt {
+	for _, f := range pass.Files {
+		if rhs.Pos() < f.FileStart || rhs.Pos() > f.FileEnd {
+			continue
+		}
+		path, _ := astutil.PathEnclosingInterval(f, rhs.Pos(), rhs.End())
+		for i := 1; i < len(path); i++ {
+			block, ok := path[i].(*ast.BlockStmt)
+			if !ok {
+				continue
+			}
+			switch path[i-1].(type) {
+			case *ast.AssignStmt, *ast.DeclStmt:
+				return block
+			}
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// checkStaticMessage сообщает о сообщениях, текст которых вычисляется во время выполнения.
+func (l *linter) checkStaticMessage(pass *analysis.Pass, lc loggerCall, msgExpr ast.Expr, report func(analysis.Diagnostic)) {
+	if !l.cfg.StaticMessageKinds[lc.kind] || l.isStaticMessage(pass, msgExpr, map[*types.Var]bool{}) || wrapperMessage(pass, msgExpr) {
+		return
+	}
+	report(analysis.Diagnostic{
+		Pos: msgExpr.Pos(),
+		End: msgExpr.End(),
+		Message: string(rules.RStaticMessage) + " log message is computed at runtime; use a constant message" +
+			" and pass values as attributes (" + lc.kind + ")",
+	})
+}
+
+// isStaticMessage проверяет, что каждая часть сообщения — текст, значение из message_packages или такая переменная.
+func (l *linter) isStaticMessage(pass *analysis.Pass, expr ast.Expr, seen map[*types.Var]bool) bool {
+	parts := messageParts(pass, expr)
+	if len(parts) != 1 || parts[0].static || parts[0].expr == nil || ast.Unparen(parts[0].expr) != ast.Unparen(expr) {
+		for _, p := range parts {
+			if !p.static && (p.expr == nil || !l.isStaticMessage(pass, p.expr, seen)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var obj types.Object
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		obj = pass.TypesInfo.Uses[e]
+	case *ast.SelectorExpr:
+		obj = pass.TypesInfo.Uses[e.Sel]
+	case *ast.IndexExpr:
+		return l.isStaticMessage(pass, e.X, seen)
+	case *ast.CallExpr:
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return l.isStaticMessage(pass, e.Args[0], seen)
+		}
+		if fn := typeutil.Callee(pass.TypesInfo, e); fn != nil {
+			return l.isMessagePackage(fn)
+		}
+		return false
+	default:
+		return false
+	}
+	if obj == nil {
+		return false
+	}
+	if l.isMessagePackage(obj) {
+		return true
+	}
+
+	v, ok := obj.(*types.Var)
+	if !ok || seen[v] || v.Parent() == nil || v.Parent() == v.Pkg().Scope() {
+		return false
+	}
+	seen[v] = true
+	body := enclosingFuncBody(pass, expr)
+	if body == nil {
+		return false
+	}
+	assigns := varAssignments(pass, body, v)
+	if len(assigns) == 0 {
+		return false
+	}
+	for _, rhs := range assigns {
+		if !l.isStaticMessage(pass, rhs, seen) {
+			return false
+		}
+	}
+	return true
+}
+
+// isMessagePackage сообщает, что объект объявлен в пакете каталога сообщений.
+func (l *linter) isMessagePackage(obj types.Object) bool {
+	return obj.Pkg() != nil && slices.Contains(l.cfg.MessagePackages, obj.Pkg().Path())
+}
+
+// wrapperMessage проверяет, что сообщение в теле обёртки (wrapperFact) взято из её параметра:
+// такое сообщение проверяется на вызовах обёртки.
+func wrapperMessage(pass *analysis.Pass, msgExpr ast.Expr) bool {
+	for _, f := range pass.Files {
+		if msgExpr.Pos() < f.FileStart || msgExpr.Pos() > f.FileEnd {
+			continue
+		}
+		path, _ := astutil.PathEnclosingInterval(f, msgExpr.Pos(), msgExpr.End())
+		for _, n := range path {
+			fd, ok := n.(*ast.FuncDecl)
+			if !ok {
+				continue
+			}
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			fact := new(wrapperFact)
+			if !ok || !pass.ImportObjectFact(fn, fact) || fact.MsgIdx >= fn.Signature().Params().Len() {
+				return false
+			}
+			param := fn.Signature().Params().At(fact.MsgIdx)
+			found := false
+			ast.Inspect(msgExpr, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok && pass.TypesInfo.Uses[id] == param {
+					found = true
+				}
+				return !found
+			})
+			return found
+		}
+	}
+	return false
+}
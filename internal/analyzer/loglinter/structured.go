@@ -0,0 +1,276 @@
+package loglinter
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/types"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/iconfire7/loglintergo/internal/printf"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// danglingWords — служебные слова, теряющие смысл без значения ("created in %dms" -> "created").
+var danglingWords = map[string]bool{
+	"in": true, "for": true, "with": true, "at": true, "of": true, "to": true,
+	"from": true, "by": true, "after": true, "on": true, "as": true,
+}
+
+// structuredFix переносит значения из сообщения в атрибуты: slog.Info("user " + id) -> slog.Info("user", "id", id).
+func (l *linter) structuredFix(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr) (analysis.SuggestedFix, bool) {
+	if call.Ellipsis.IsValid() || lc.printf || (!lc.kv && !lc.fields) {
+		return analysis.SuggestedFix{}, false
+	}
+	if _, isCall := ast.Unparen(msgExpr).(*ast.CallExpr); !isCall {
+		if _, isBin := ast.Unparen(msgExpr).(*ast.BinaryExpr); !isBin {
+			return analysis.SuggestedFix{}, false
+		}
+	}
+
+	parts := messageParts(pass, msgExpr)
+	if !hasDynamicPart(parts) {
+		return analysis.SuggestedFix{}, false
+	}
+	// Чувствительное значение нельзя переносить в атрибут: сообщение исправляет LOG004.
+	if static, ok := extractStaticText(pass, msgExpr); ok && l.cfg.Rules.Sensitive {
+		if _, found := rules.NoSensitivePatterns(static, l.sensitive); found {
+			return analysis.SuggestedFix{}, false
+		}
+	}
+	text, ok := structuredText(parts)
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+	if text, ok = l.fixStructuredText(text); !ok {
+		return analysis.SuggestedFix{}, false
+	}
+
+	ctor := ""
+	if !lc.kv {
+		pkgPath := zapPath
+		if lc.kind == "slog" {
+			pkgPath = slogPath
+		}
+		name, ok := importName(pass, call, pkgPath)
+		if !ok {
+			return analysis.SuggestedFix{}, false
+		}
+		ctor = name + ".Any"
+	}
+
+	var attrs strings.Builder
+	used := map[string]bool{}
+	for _, p := range parts {
+		if p.static {
+			continue
+		}
+		if p.expr == nil {
+			return analysis.SuggestedFix{}, false
+		}
+		var src bytes.Buffer
+		if err := format.Node(&src, pass.Fset, p.expr); err != nil {
+			return analysis.SuggestedFix{}, false
+		}
+		name := attrName(pass, p.expr)
+		if name == "" {
+			return analysis.SuggestedFix{}, false
+		}
+		key := l.uniqueAttrName(name, used)
+		if ctor != "" {
+			attrs.WriteString(", " + ctor + "(" + strconv.Quote(key) + ", " + src.String() + ")")
+		} else {
+			attrs.WriteString(", " + strconv.Quote(key) + ", " + src.String())
+		}
+	}
+
+	last := call.Args[len(call.Args)-1]
+	return analysis.SuggestedFix{
+		Message: "move values into structured attributes",
+		TextEdits: []analysis.TextEdit{
+			{Pos: msgExpr.Pos(), End: msgExpr.End(), NewText: []byte(strconv.Quote(text))},
+			{Pos: last.End(), End: last.End(), NewText: []byte(attrs.String())},
+		},
+	}, true
+}
+
+// structuredText возвращает текст сообщения без значений и приклеенных к ним символов ("%dms", "token=%s").
+func structuredText(parts []msgPart) (string, bool) {
+	// Значение занимает в тексте один байт, отмеченный в dyn: так байт NUL в самом сообщении
+	// значением не считается.
+	var (
+		text []byte
+		dyn  []bool
+	)
+	value := func() {
+		text = append(text, 0)
+		dyn = append(dyn, true)
+	}
+	literal := func(s string) {
+		text = append(text, s...)
+		dyn = append(dyn, make([]bool, len(s))...)
+	}
+	for _, p := range parts {
+		switch {
+		case !p.static:
+			value()
+		case p.format:
+			for _, seg := range printf.Parse(p.text) {
+				if seg.Directive != nil {
+					value()
+				} else {
+					literal(seg.Text)
+				}
+			}
+		default:
+			literal(p.text)
+		}
+	}
+
+	var words []string
+	for from := 0; from < len(text); {
+		r, size := utf8.DecodeRune(text[from:])
+		if !dyn[from] && unicode.IsSpace(r) {
+			from += size
+			continue
+		}
+		end, cut := from, -1
+		for end < len(text) {
+			r, size := utf8.DecodeRune(text[end:])
+			if !dyn[end] && unicode.IsSpace(r) {
+				break
+			}
+			if dyn[end] && cut < 0 {
+				cut = end
+			}
+			end += size
+		}
+		w, before := string(text[from:end]), ""
+		if cut >= 0 {
+			before = string(text[from:cut])
+		}
+		from = end
+		if cut < 0 {
+			words = append(words, w)
+			continue
+		}
+		// Значение удалено: убираем приклеенный хвост (единицы измерения) и разделители.
+		before = strings.TrimRightFunc(before, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if before != "" {
+			words = append(words, before)
+			continue
+		}
+		if n := len(words); n > 0 && danglingWords[strings.ToLower(words[n-1])] {
+			words = words[:n-1]
+		}
+	}
+	result := strings.Join(words, " ")
+	return result, result != ""
+}
+
+// attrName выводит имя атрибута из выражения значения или возвращает "", если имени нет.
+func attrName(pass *analysis.Pass, expr ast.Expr) string {
+	switch e := ast.Unparen(expr).(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	case *ast.IndexExpr:
+		return attrName(pass, e.X)
+	case *ast.IndexListExpr:
+		return attrName(pass, e.X)
+	case *ast.SliceExpr:
+		return attrName(pass, e.X)
+	case *ast.TypeAssertExpr:
+		return attrName(pass, e.X)
+	case *ast.StarExpr:
+		return attrName(pass, e.X)
+	case *ast.UnaryExpr:
+		return attrName(pass, e.X)
+	case *ast.CallExpr:
+		if len(e.Args) == 1 {
+			if text, ok := staticKey(pass, e.Args[0]); ok && text != "" {
+				return text
+			}
+		}
+		if tv, ok := pass.TypesInfo.Types[e.Fun]; ok && tv.IsType() && len(e.Args) == 1 {
+			return attrName(pass, e.Args[0])
+		}
+		if fn, ok := typeutil.Callee(pass.TypesInfo, e).(*types.Func); ok {
+			if fn.Name() == "String" || fn.Name() == "Error" {
+				if sel, ok := ast.Unparen(e.Fun).(*ast.SelectorExpr); ok {
+					return attrName(pass, sel.X)
+				}
+			}
+			if name := strings.TrimPrefix(fn.Name(), "Get"); name != "" {
+				return name
+			}
+			return fn.Name()
+		}
+		return attrName(pass, e.Fun)
+	}
+	return ""
+}
+
+// fixStructuredText вносит в новый текст сообщения исправления LOG003 и LOG001.
+func (l *linter) fixStructuredText(text string) (string, bool) {
+	for _, id := range []rules.RuleID{rules.RNoEmojiSpecial, rules.RLowercaseStart} {
+		for _, v := range rules.CheckAll(text, nil, l.cfg.Rules, l.sensitive) {
+			if fixed, ok := suggestFixForViolation(id, text, nil); v.ID == id && ok {
+				text = strings.Join(strings.Fields(fixed), " ")
+			}
+		}
+	}
+	return text, text != ""
+}
+
+// uniqueAttrName приводит имя к соглашению о ключах и делает его уникальным в вызове.
+func (l *linter) uniqueAttrName(name string, used map[string]bool) string {
+	style := rules.KeyStyleSnake
+	if l.keyStyle.Re != nil && l.keyStyle.Name != rules.KeyStyleRegex {
+		style = l.keyStyle.Name
+	}
+	if converted, ok := rules.ConvertKey(name, style); ok && converted != "" {
+		name = converted
+	}
+	key := name
+	for i := 2; used[key]; i++ {
+		key = name + strconv.Itoa(i)
+	}
+	used[key] = true
+	return key
+}
+
+// importName возвращает имя, под которым пакет pkgPath импортирован в файле вызова.
+func importName(pass *analysis.Pass, node ast.Node, pkgPath string) (string, bool) {
+	for _, f := range pass.Files {
+		if node.Pos() < f.FileStart || node.Pos() > f.FileEnd {
+			continue
+		}
+		for _, imp := range f.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil || path != pkgPath {
+				continue
+			}
+			if imp.Name != nil {
+				if imp.Name.Name == "_" || imp.Name.Name == "." {
+					return "", false
+				}
+				return imp.Name.Name, true
+			}
+			for _, p := range pass.Pkg.Imports() {
+				if p.Path() == pkgPath {
+					return p.Name(), true
+				}
+			}
+		}
+	}
+	return "", false
+}
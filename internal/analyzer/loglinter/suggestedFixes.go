@@ -1,33 +1,102 @@
 package loglinter
 
 import (
+	"github.com/iconfire7/loglintergo/internal/printf"
 	"github.com/iconfire7/loglintergo/internal/rules"
 	"go/ast"
 	"go/token"
 	"golang.org/x/tools/go/analysis"
+	"strconv"
 	"strings"
 	"unicode"
 )
 
-func fixTargetForFirstArg(pass *analysis.Pass, call *ast.CallExpr) (pos, end token.Pos, ok bool) {
-	if len(call.Args) == 0 {
-		return token.NoPos, token.NoPos, false
+// fixEdits строит правки для исправления нарушения id. Правится каждый строковый литерал,
+// из которого составлены статические части сообщения (см. stringLeaves): операнды
+// конкатенаций и объявления констант пакета правятся на месте, поэтому ссылки на другие
+// константы сохраняются, а динамические части остаются без изменений.
+// template — сообщение является шаблоном printf-метода, директивы в нём не исправляются.
+func fixEdits(pass *analysis.Pass, expr ast.Expr, id rules.RuleID, template bool) []analysis.TextEdit {
+	var edits []analysis.TextEdit
+	seen := map[token.Pos]bool{}
+	for i, p := range messageParts(pass, expr) {
+		if !p.static {
+			continue
+		}
+		// Заглавную букву исправляем, только если сообщение начинается со статической части.
+		if id == rules.RLowercaseStart && i > 0 {
+			break
+		}
+		leaves, ok := stringLeaves(pass, p.expr)
+		if !ok {
+			continue
+		}
+		var mask rules.Mask
+		if p.format || template {
+			mask = printf.Mask(p.text)
+		}
+
+		off := 0
+		for _, lit := range leaves {
+			text, err := leafText(lit)
+			if err != nil || off+len(text) > len(p.text) || p.text[off:off+len(text)] != text {
+				break
+			}
+			var leafMask rules.Mask
+			if mask != nil {
+				leafMask = mask[off : off+len(text)]
+			}
+			off += len(text)
+
+			fixed, ok := suggestFixForViolation(id, text, leafMask)
+			if id == rules.RLowercaseStart && strings.TrimSpace(text) != "" {
+				// Первая буква сообщения — в первом непустом литерале, дальше не ищем.
+				if ok && lit.Kind == token.STRING && !seen[lit.Pos()] {
+					edits = append(edits, analysis.TextEdit{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(fixed))})
+				}
+				return edits
+			}
+			if !ok || fixed == text || lit.Kind != token.STRING || seen[lit.Pos()] {
+				continue
+			}
+			seen[lit.Pos()] = true
+			edits = append(edits, analysis.TextEdit{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(fixed))})
+		}
 	}
-	expr := call.Args[0]
+	return edits
+}
 
-	if ce, ok := expr.(*ast.CallExpr); ok && isFmtSprintf(pass, ce) && len(ce.Args) > 0 {
-		return ce.Args[0].Pos(), ce.Args[0].End(), true
+// leafText возвращает значение литерала из stringLeaves.
+func leafText(lit *ast.BasicLit) (string, error) {
+	if lit.Kind == token.CHAR {
+		r, _, _, err := strconv.UnquoteChar(lit.Value[1:len(lit.Value)-1], '\'')
+		return string(r), err
 	}
+	return strconv.Unquote(lit.Value)
+}
 
-	return expr.Pos(), expr.End(), true
+// fixTargetForPart возвращает диапазон исходника статической части сообщения, если она
+// задана одним строковым литералом (напрямую или через константу пакета). Составные
+// выражения целиком не заменяются, чтобы не потерять ссылки на другие константы.
+func fixTargetForPart(pass *analysis.Pass, p msgPart) (pos, end token.Pos, ok bool) {
+	if p.expr == nil {
+		return token.NoPos, token.NoPos, false
+	}
+	leaves, ok := stringLeaves(pass, p.expr)
+	if !ok || len(leaves) != 1 || leaves[0].Kind != token.STRING {
+		return token.NoPos, token.NoPos, false
+	}
+	return leaves[0].Pos(), leaves[0].End(), true
 }
 
-func suggestFixForViolation(id rules.RuleID, msg string) (string, bool) {
+// suggestFixForViolation исправляет текст msg; байты, отмеченные в mask (директивы шаблона,
+// см. checkedText), остаются без изменений.
+func suggestFixForViolation(id rules.RuleID, msg string, mask rules.Mask) (string, bool) {
 	switch id {
 	case rules.RLowercaseStart:
 		return fixLowercaseStart(msg)
 	case rules.RNoEmojiSpecial:
-		return fixNoEmojiOrSpecial(msg)
+		return fixNoEmojiOrSpecial(msg, mask)
 	case rules.RSensitive:
 		return "", false
 	case rules.REnglishOnly:
@@ -59,11 +128,15 @@ func fixLowercaseStart(msg string) (string, bool) {
 	return "", false
 }
 
-func fixNoEmojiOrSpecial(msg string) (string, bool) {
+func fixNoEmojiOrSpecial(msg string, mask rules.Mask) (string, bool) {
 	var b strings.Builder
 	b.Grow(len(msg))
 	changed := false
-	for _, r := range msg {
+	for i, r := range msg {
+		if i < len(mask) && mask[i] {
+			b.WriteRune(r)
+			continue
+		}
 		if !rules.IsAllowedLogChar(r) {
 			changed = true
 			continue
@@ -0,0 +1,307 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/iconfire7/loglintergo/internal/config"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ssa"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// funcKey — функция пакета (recv пуст) или метод именованного типа.
+type funcKey struct {
+	pkgPath string
+	recv    string
+	name    string
+}
+
+// funcSet — набор функций каталога taint-анализа.
+type funcSet map[funcKey]bool
+
+// defaultTaintSources — встроенные источники недоверенных данных.
+var defaultTaintSources = []config.TaintFunc{
+	{Package: "net/http", Receiver: "Request", Methods: []string{
+		"FormValue", "PostFormValue", "Cookie", "Cookies", "BasicAuth", "UserAgent", "Referer", "FormFile",
+	}},
+	{Package: "net/http", Receiver: "Header", Methods: []string{"Get", "Values"}},
+	{Package: "net/url", Receiver: "URL", Methods: []string{"Query"}},
+	{Package: "net/url", Receiver: "Values", Methods: []string{"Get"}},
+	{Package: "net/url", Receiver: "Userinfo", Methods: []string{"Username", "Password"}},
+	{Package: "os", Methods: []string{"Getenv", "LookupEnv", "Environ"}},
+	{Package: "flag", Methods: []string{"String", "Arg", "Args", "Lookup"}},
+	{Package: "flag", Receiver: "FlagSet", Methods: []string{"String", "Arg", "Args", "Lookup"}},
+}
+
+func newFuncSet(lists ...[]config.TaintFunc) funcSet {
+	set := funcSet{}
+	for _, list := range lists {
+		for _, f := range list {
+			for _, m := range f.Methods {
+				set[funcKey{f.Package, f.Receiver, m}] = true
+			}
+		}
+	}
+	return set
+}
+
+// has сообщает, что fn входит в набор.
+func (s funcSet) has(fn *types.Func) bool {
+	if fn == nil || fn.Pkg() == nil {
+		return false
+	}
+	return s[keyOf(fn)]
+}
+
+func keyOf(fn *types.Func) funcKey {
+	k := funcKey{pkgPath: fn.Pkg().Path(), name: fn.Name()}
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		if named := derefNamed(recv.Type()); named != nil {
+			k.recv = named.Obj().Name()
+		}
+	}
+	return k
+}
+
+// funcName возвращает короткое имя функции для диагностик: os.Getenv, http.Header.Get.
+func funcName(fn *types.Func) string {
+	name := fn.Pkg().Name() + "."
+	if k := keyOf(fn); k.recv != "" {
+		name += k.recv + "."
+	}
+	return name + fn.Name()
+}
+
+// defaultEscapers — встроенные функции экранирования: их результат не содержит переводов строк
+// и управляющих символов, поэтому безопасен в тексте сообщения.
+var defaultEscapers = []config.TaintFunc{
+	{Package: "strconv", Methods: []string{"Quote", "QuoteToASCII", "QuoteToGraphic"}},
+	{Package: "net/url", Methods: []string{"QueryEscape", "PathEscape"}},
+	{Package: "html", Methods: []string{"EscapeString"}},
+}
+
+// taintAnalysis — каталог taint-анализа, подготовленный из настроек.
+type taintAnalysis struct {
+	sources    funcSet
+	sanitizers funcSet
+	sinks      funcSet
+	// escapers — санитайзеры для правила инъекций: sanitizers и функции экранирования.
+	escapers funcSet
+}
+
+func newTaintAnalysis(cfg config.Taint) *taintAnalysis {
+	return &taintAnalysis{
+		sources:    newFuncSet(defaultTaintSources, cfg.Sources),
+		sanitizers: newFuncSet(cfg.Sanitizers),
+		sinks:      newFuncSet(cfg.Sinks),
+		escapers:   newFuncSet(cfg.Sanitizers, defaultEscapers, cfg.Escapers),
+	}
+}
+
+// taintStep — шаг пути недоверенного значения от источника к аргументу.
+type taintStep struct {
+	pos token.Pos
+	msg string
+}
+
+// taintSource — найденный источник и путь от него.
+type taintSource struct {
+	fn    *types.Func
+	steps []taintStep
+}
+
+// ssaCallee возвращает вызываемую функцию или метод интерфейса.
+func ssaCallee(common *ssa.CallCommon) *types.Func {
+	if common.IsInvoke() {
+		return common.Method
+	}
+	if callee := common.StaticCallee(); callee != nil {
+		if fn, ok := callee.Object().(*types.Func); ok {
+			return fn.Origin()
+		}
+	}
+	return nil
+}
+
+// source ищет путь от источника недоверенных данных до значения v. Значение считается
+// недоверенным, если оно получено из источника, вычислено из недоверенных значений
+// (конкатенация, преобразование, вызов с недоверенным аргументом или получателем) или
+// сохранено в локальную переменную из недоверенного значения. Функции из cut, встроенные
+// len/cap и значения логического и числовых типов путь обрывают.
+func (t *taintAnalysis) source(v ssa.Value, cut funcSet, seen map[ssa.Value]bool) (taintSource, bool) {
+	if v == nil || seen[v] {
+		return taintSource{}, false
+	}
+	seen[v] = true
+
+	if b, ok := v.Type().Underlying().(*types.Basic); ok && b.Info()&(types.IsBoolean|types.IsNumeric) != 0 {
+		return taintSource{}, false
+	}
+
+	step := func(src taintSource, pos token.Pos, msg string) (taintSource, bool) {
+		if pos.IsValid() {
+			src.steps = append(src.steps, taintStep{pos: pos, msg: msg})
+		}
+		return src, true
+	}
+	operands := func(vals ...ssa.Value) (taintSource, bool) {
+		for _, op := range vals {
+			if src, ok := t.source(op, cut, seen); ok {
+				return src, true
+			}
+		}
+		return taintSource{}, false
+	}
+
+	switch v := v.(type) {
+	case *ssa.Call:
+		common := v.Common()
+		fn := ssaCallee(common)
+		if t.sources.has(fn) {
+			return taintSource{fn: fn, steps: []taintStep{{pos: v.Pos(), msg: "untrusted value comes from " + funcName(fn)}}}, true
+		}
+		if cut.has(fn) {
+			return taintSource{}, false
+		}
+		if b, ok := common.Value.(*ssa.Builtin); ok && (b.Name() == "len" || b.Name() == "cap") {
+			return taintSource{}, false
+		}
+		vals := append([]ssa.Value{}, common.Args...)
+		if common.IsInvoke() {
+			vals = append(vals, common.Value)
+		}
+		src, ok := operands(vals...)
+		if !ok {
+			return taintSource{}, false
+		}
+		if fn != nil && fn.Pkg() != nil {
+			return step(src, v.Pos(), "passed through "+funcName(fn))
+		}
+		return src, true
+
+	case *ssa.BinOp:
+		src, ok := operands(v.X, v.Y)
+		if !ok {
+			return taintSource{}, false
+		}
+		return step(src, v.Pos(), "concatenated here")
+
+	case *ssa.Phi:
+		return operands(v.Edges...)
+	case *ssa.Extract:
+		return operands(v.Tuple)
+	case *ssa.ChangeType:
+		return operands(v.X)
+	case *ssa.Convert:
+		return operands(v.X)
+	case *ssa.MakeInterface:
+		return operands(v.X)
+	case *ssa.ChangeInterface:
+		return operands(v.X)
+	case *ssa.TypeAssert:
+		return operands(v.X)
+	case *ssa.Slice:
+		return operands(v.X)
+	case *ssa.Field:
+		return operands(v.X)
+	case *ssa.FieldAddr:
+		return operands(v.X)
+	case *ssa.Index:
+		return operands(v.X)
+	case *ssa.IndexAddr:
+		return operands(v.X)
+	case *ssa.Lookup:
+		return operands(v.X)
+	case *ssa.UnOp:
+		return operands(v.X)
+	case *ssa.Alloc:
+		return t.storedSource(v, cut, seen)
+	}
+	return taintSource{}, false
+}
+
+// storedSource ищет недоверенное значение среди записей в память addr (локальная переменная,
+// массив аргументов, поле структуры), включая записи в её элементы и поля.
+func (t *taintAnalysis) storedSource(addr ssa.Value, cut funcSet, seen map[ssa.Value]bool) (taintSource, bool) {
+	refs := addr.Referrers()
+	if refs == nil {
+		return taintSource{}, false
+	}
+	for _, ref := range *refs {
+		switch ref := ref.(type) {
+		case *ssa.Store:
+			if ref.Addr != addr {
+				continue
+			}
+			if src, ok := t.source(ref.Val, cut, seen); ok {
+				if ref.Pos().IsValid() {
+					src.steps = append(src.steps, taintStep{pos: ref.Pos(), msg: "stored here"})
+				}
+				return src, true
+			}
+		case *ssa.IndexAddr:
+			if ref.X == addr {
+				if src, ok := t.storedSource(ref, cut, seen); ok {
+					return src, true
+				}
+			}
+		case *ssa.FieldAddr:
+			if ref.X == addr {
+				if src, ok := t.storedSource(ref, cut, seen); ok {
+					return src, true
+				}
+			}
+		}
+	}
+	return taintSource{}, false
+}
+
+// checkTaint сообщает об аргументах вызовов логгеров и функций из taint.sinks, в которые
+// попадают недоверенные значения. Путь от источника выводится в RelatedInformation.
+func (l *linter) checkTaint(pass *analysis.Pass, info *buildssa.SSA, call *ast.CallExpr, report func(analysis.Diagnostic)) {
+	kind, msgIdx := "", -1
+	if lc, ok := l.detector.detectLoggerCall(pass, call); ok {
+		kind = lc.kind
+		// Недоверенное значение в тексте сообщения уже отмечает LOG010.
+		if msgExpr, ok := lc.msgArg(call); ok && l.cfg.Rules.Injection {
+			if _, _, ok := l.injectionSource(pass, info, call, lc, msgExpr); ok {
+				msgIdx = lc.msgIdx
+			}
+		}
+	} else if fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func); ok && l.taint.sinks.has(fn) {
+		kind = funcName(fn)
+	} else {
+		return
+	}
+
+	args := ssaCallArgs(info, call)
+	for i, v := range args {
+		if v == nil || i == msgIdx {
+			continue
+		}
+		src, ok := l.taint.source(v, l.taint.sanitizers, map[ssa.Value]bool{})
+		if !ok {
+			continue
+		}
+		arg := call.Args[i]
+		report(analysis.Diagnostic{
+			Pos:     arg.Pos(),
+			End:     arg.End(),
+			Message: string(rules.RTaint) + " log argument carries untrusted data from " + funcName(src.fn) + " (" + kind + ")",
+			Related: src.related(),
+		})
+	}
+}
+
+// related возвращает путь значения от источника в виде связанной информации диагностики.
+func (src taintSource) related() []analysis.RelatedInformation {
+	related := make([]analysis.RelatedInformation, 0, len(src.steps))
+	for _, s := range src.steps {
+		related = append(related, analysis.RelatedInformation{Pos: s.pos, Message: s.msg})
+	}
+	return related
+}
@@ -0,0 +1,30 @@
+package attrkeys
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const keyReqID = "requestID"
+
+func pairs(l *slog.Logger, dyn string) {
+	slog.Info("started", "userID", 1) // want `LOG005 attribute key "userID" does not match snake_case convention \(slog\)`
+	slog.Info("started", "user_id", 1, "http.status_code", 200)
+	slog.Info("started", dyn, 1)
+	l.With("traceID", 1).Info("ok") // want `LOG005 attribute key "traceID"`
+}
+
+func attrs() {
+	slog.Info("started", slog.String("ReqPath", "/"))           // want `LOG005 attribute key "ReqPath"`
+	slog.Info("started", slog.Int(keyReqID, 1))                 // want `LOG005 attribute key "requestID"`
+	slog.Info("started", slog.Group("http", "StatusCode", 200)) // want `LOG005 attribute key "StatusCode"`
+	slog.Info("started", slog.Any("req_id", 1), slog.Bool("ok", true))
+}
+
+func fields(z *zap.Logger, s *zap.SugaredLogger) {
+	z.Info("started", zap.String("user-id", "x"), zap.Int("count", 1)) // want `LOG005 attribute key "user-id" does not match snake_case convention \(zap\)`
+	z.Info("started", zap.Any("Payload", 1))                           // want `LOG005 attribute key "Payload"`
+	s.Infow("started", "someKey", 1)                                   // want `LOG005 attribute key "someKey" does not match snake_case convention \(zap-sugar\)`
+	s.With("a_b", 1, "CamelKey", 2).Infow("ok")                        // want `LOG005 attribute key "CamelKey"`
+}
@@ -0,0 +1,9 @@
+package attrkeyscamel
+
+import "log/slog"
+
+func f() {
+	slog.Info("started", "userID", 1, "http.statusCode", 200)
+	slog.Info("started", "user_id", 1)  // want `LOG005 attribute key "user_id" does not match camelCase convention \(slog\)`
+	slog.Info("started", "UserName", 1) // want `LOG005 attribute key "UserName" does not match camelCase convention \(slog\)`
+}
@@ -0,0 +1,10 @@
+// Package audit — функции аудита, сообщение которых передаётся вторым аргументом.
+package audit
+
+import "context"
+
+type Recorder struct{}
+
+func Record(ctx context.Context, msg string, kv ...any) {}
+
+func (r *Recorder) Record(ctx context.Context, msg string, kv ...any) {}
@@ -0,0 +1,6 @@
+// Package constlib объявляет сообщения в другом пакете: их исправить нельзя.
+package constlib
+
+type Msg string
+
+const Started Msg = "Service Started"
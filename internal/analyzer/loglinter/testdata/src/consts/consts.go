@@ -0,0 +1,43 @@
+package consts
+
+import (
+	"log/slog"
+
+	"constlib"
+)
+
+const msgStarted = "Server Started" // want `LOG001 log message must not start with an uppercase letter \(slog\)`
+
+const (
+	ok, bad = "ok", "Bad!" // want `LOG001` `LOG003`
+)
+
+const (
+	prefix  = "xyz "
+	launch  = prefix + "yz 🚀" // want `LOG003`
+	upper   = "Upper "        // want `LOG001`
+	derived = upper + "case message"
+	noisy   = "noisy ✨ " + prefix // want `LOG003`
+	twice   = noisy + "again"
+)
+
+type Text string
+
+const typed Text = "Typed message" // want `LOG001`
+
+func constants() {
+	slog.Info(msgStarted)
+	slog.Warn(msgStarted)
+	slog.Info(bad)
+	slog.Info(ok)
+	slog.Info(launch)
+	slog.Info(derived)
+	slog.Info(twice)
+	slog.Info(string(typed))
+
+	slog.Info(string(constlib.Started)) // want `LOG001`
+
+	const local = "Local message" // want `LOG001`
+	slog.Info((local))
+	slog.Info("Inline " + "constant") // want `LOG001`
+}
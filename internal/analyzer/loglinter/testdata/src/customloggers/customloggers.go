@@ -0,0 +1,27 @@
+package customloggers
+
+import (
+	"context"
+	"log/slog"
+
+	"audit"
+	"obs"
+)
+
+type embedded struct{ *slog.Logger }
+
+func custom(ctx context.Context, r *audit.Recorder, tk string) {
+	obs.Logger.Infow("Started")       // want `LOG001 log message must not start with an uppercase letter \(obs\)`
+	obs.Logger.Debugf("token=%s", tk) // want `LOG004 log message matches sensitive pattern \(obs\)`
+	audit.Record(ctx, "Recorded")     // want `LOG001 .*\(audit\)`
+	r.Record(ctx, "Recorded")         // want `LOG001 .*\(audit\)`
+
+	obs.Logger.Infow("started")
+	obs.Logger.Debugf("retry %d", 1)
+	audit.Record(ctx, "recorded")
+	obs.Logger.Trace("Not configured")
+}
+
+func embedding(e embedded) {
+	e.Info("Embedded") // want `LOG001 .*\(slog\)`
+}
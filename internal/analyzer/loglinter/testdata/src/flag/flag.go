@@ -0,0 +1,18 @@
+// Пакет flag — заглушка flag для проверки каталога источников по умолчанию.
+package flag
+
+type Flag struct {
+	Name string
+}
+
+type FlagSet struct{}
+
+func (f *FlagSet) String(name, value, usage string) *string { return new(string) }
+func (f *FlagSet) Arg(i int) string                         { return "" }
+func (f *FlagSet) Args() []string                           { return nil }
+func (f *FlagSet) Lookup(name string) *Flag                 { return nil }
+
+func String(name, value, usage string) *string { return new(string) }
+func Arg(i int) string                         { return "" }
+func Args() []string                           { return nil }
+func Lookup(name string) *Flag                 { return nil }
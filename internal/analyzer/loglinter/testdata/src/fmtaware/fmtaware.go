@@ -0,0 +1,28 @@
+package fmtaware
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+func f(id string, n int, p *int) {
+	log.Printf("value %#x of %*d and %[1]q", n, 3, n)
+	log.Printf("%s Failed", id)
+	slog.Info(fmt.Sprintf("item %+v (%#v)", id, id)) // want `LOG003` `LOG012`
+	log.Printf("rate 100%% of %d!", n)               // want `LOG003 .*"!"`
+	log.Printf("queue is full 🚀 %d", n)              // want `LOG003`
+	log.Printf("користувач %s", id)                  // want `LOG002` `LOG003`
+	log.Printf("Closed %s", id)                      // want `LOG001`
+	log.Printf(`raw \n Ж %d`, n)                     // want `LOG002` `LOG003 .*U\+005C`
+	log.Printf("esc\té ok\x41 Ж %d", n)              // want `LOG002` `LOG003 .*U\+00E9`
+}
+
+// Байт NUL в тексте — обычный недопустимый символ, а не маска директивы.
+func nul(id string, n int) {
+	log.Printf("value\x00 %d", n) // want `LOG003 .*U\+0000`
+	slog.Info("user\x00 created") // want `LOG003 .*U\+0000`
+	// %Ж — директива с неизвестным глаголом, а не текст: LOG002 на неё нет.
+	log.Printf("%Ж verb %d", n, n) // want `LOG015 unknown printf verb`
+	slog.Info("user\x00 " + id)    // want `LOG003 .*U\+0000` `LOG012`
+}
@@ -0,0 +1,73 @@
+package zap
+
+import (
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+type Field = zapcore.Field
+
+const InfoLevel = zapcore.Level(0)
+
+type Logger struct{}
+
+func NewNop() *Logger { return &Logger{} }
+
+func (l *Logger) Debug(msg string, fields ...Field)                         {}
+func (l *Logger) Info(msg string, fields ...Field)                          {}
+func (l *Logger) Warn(msg string, fields ...Field)                          {}
+func (l *Logger) Error(msg string, fields ...Field)                         {}
+func (l *Logger) DPanic(msg string, fields ...Field)                        {}
+func (l *Logger) Panic(msg string, fields ...Field)                         {}
+func (l *Logger) Fatal(msg string, fields ...Field)                         {}
+func (l *Logger) Log(lvl zapcore.Level, msg string, fields ...Field)        {}
+func (l *Logger) Check(lvl zapcore.Level, msg string) *zapcore.CheckedEntry { return nil }
+func (l *Logger) With(fields ...Field) *Logger                              { return l }
+func (l *Logger) Sugar() *SugaredLogger                                     { return &SugaredLogger{} }
+
+type SugaredLogger struct{}
+
+func (s *SugaredLogger) Debug(args ...interface{})                                    {}
+func (s *SugaredLogger) Debugf(template string, args ...interface{})                  {}
+func (s *SugaredLogger) Debugw(msg string, keysAndValues ...interface{})              {}
+func (s *SugaredLogger) Debugln(args ...interface{})                                  {}
+func (s *SugaredLogger) Info(args ...interface{})                                     {}
+func (s *SugaredLogger) Infof(template string, args ...interface{})                   {}
+func (s *SugaredLogger) Infow(msg string, keysAndValues ...interface{})               {}
+func (s *SugaredLogger) Infoln(args ...interface{})                                   {}
+func (s *SugaredLogger) Warn(args ...interface{})                                     {}
+func (s *SugaredLogger) Warnf(template string, args ...interface{})                   {}
+func (s *SugaredLogger) Warnw(msg string, keysAndValues ...interface{})               {}
+func (s *SugaredLogger) Warnln(args ...interface{})                                   {}
+func (s *SugaredLogger) Error(args ...interface{})                                    {}
+func (s *SugaredLogger) Errorf(template string, args ...interface{})                  {}
+func (s *SugaredLogger) Errorw(msg string, keysAndValues ...interface{})              {}
+func (s *SugaredLogger) Errorln(args ...interface{})                                  {}
+func (s *SugaredLogger) DPanic(args ...interface{})                                   {}
+func (s *SugaredLogger) DPanicf(template string, args ...interface{})                 {}
+func (s *SugaredLogger) DPanicw(msg string, keysAndValues ...interface{})             {}
+func (s *SugaredLogger) DPanicln(args ...interface{})                                 {}
+func (s *SugaredLogger) Panic(args ...interface{})                                    {}
+func (s *SugaredLogger) Panicf(template string, args ...interface{})                  {}
+func (s *SugaredLogger) Panicw(msg string, keysAndValues ...interface{})              {}
+func (s *SugaredLogger) Panicln(args ...interface{})                                  {}
+func (s *SugaredLogger) Fatal(args ...interface{})                                    {}
+func (s *SugaredLogger) Fatalf(template string, args ...interface{})                  {}
+func (s *SugaredLogger) Fatalw(msg string, keysAndValues ...interface{})              {}
+func (s *SugaredLogger) Fatalln(args ...interface{})                                  {}
+func (s *SugaredLogger) Log(lvl zapcore.Level, args ...interface{})                   {}
+func (s *SugaredLogger) Logf(lvl zapcore.Level, template string, args ...interface{}) {}
+func (s *SugaredLogger) Logw(lvl zapcore.Level, msg string, kv ...interface{})        {}
+func (s *SugaredLogger) Logln(lvl zapcore.Level, args ...interface{})                 {}
+func (s *SugaredLogger) With(args ...interface{}) *SugaredLogger                      { return s }
+
+func String(key string, val string) Field                  { return Field{} }
+func Int(key string, val int) Field                        { return Field{} }
+func Int64(key string, val int64) Field                    { return Field{} }
+func Bool(key string, val bool) Field                      { return Field{} }
+func Duration(key string, val time.Duration) Field         { return Field{} }
+func Any(key string, value interface{}) Field              { return Field{} }
+func Error(err error) Field                                { return Field{} }
+func NamedError(key string, err error) Field               { return Field{} }
+func Object(key string, val zapcore.ObjectMarshaler) Field { return Field{} }
@@ -0,0 +1,22 @@
+package zapcore
+
+type Level int8
+
+type Field struct {
+	Key    string
+	String string
+}
+
+type ObjectEncoder interface {
+	AddString(key, value string)
+	AddInt(key string, value int)
+	AddReflected(key string, value interface{}) error
+}
+
+type ObjectMarshaler interface {
+	MarshalLogObject(ObjectEncoder) error
+}
+
+type CheckedEntry struct{}
+
+func (ce *CheckedEntry) Write(fields ...Field) {}
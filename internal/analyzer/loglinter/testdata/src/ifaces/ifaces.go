@@ -0,0 +1,54 @@
+package ifaces
+
+import (
+	"context"
+	"testing"
+)
+
+type Logger interface {
+	Info(msg string, args ...any)
+	Errorf(format string, args ...any)
+	InfoContext(ctx context.Context, msg string, args ...any)
+	Infow(msg string, kv ...any)
+	Warn(code int, args ...any)
+	Debug(msg string)
+}
+
+type Full interface {
+	Logger
+	Close() error
+}
+
+func calls(ctx context.Context, l Logger, full Full, tk string) {
+	l.Info("Started")                 // want `LOG001 log message must not start with an uppercase letter \(ifaces.Logger\)`
+	l.Errorf("token=%s", tk)          // want `LOG004 .*\(ifaces.Logger\)`
+	l.InfoContext(ctx, "Ctx message") // want `LOG001`
+	l.Infow("Kv message", "n", 1)     // want `LOG001`
+	full.Info("Embedded")             // want `LOG001 .*\(ifaces.Full\)`
+
+	l.Info("started")
+	l.Warn(1, "Not a message")
+	l.Debug("Not variadic")
+}
+
+func notLoggers(tb testing.TB) {
+	tb.Errorf("Not a logger")
+}
+
+func generic[T interface{ Info(string, ...any) }](l T) {
+	l.Info("Generic message") // want `LOG001 .*\(interface\)`
+	l.Info("generic message")
+}
+
+func constrained[T Logger](l T, tk string) {
+	l.Info("Constrained message") // want `LOG001 .*\(ifaces.Logger\)`
+	l.Errorf("token=%s", tk)      // want `LOG004 .*\(ifaces.Logger\)`
+}
+
+type store struct{}
+
+func (store) Info(key string, vals ...any) {}
+
+func concrete(s store) {
+	s.Info("Not an interface")
+}
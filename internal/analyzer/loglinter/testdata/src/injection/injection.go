@@ -0,0 +1,25 @@
+package injection
+
+import "webx"
+
+type Logger interface {
+	Infow(msg string, kv ...any)
+}
+
+func f(l Logger, r *webx.Request, retry bool) {
+	l.Infow("user " + r.FormValue("name") + " logged in")             // want `LOG010 untrusted data from webx.Request.FormValue is written into log message without escaping \(injection.Logger\)` `LOG012`
+	l.Infow("user " + webx.Quote(r.FormValue("name")) + " logged in") // want `LOG009` `LOG012`
+	l.Infow("ok", "name", r.FormValue("name"))                        // want `LOG009`
+
+	name := r.FormValue("name")
+	l.Infow("login failed for "+name, "attempt", 3)                          // want `LOG010` `LOG012`
+	l.Infow(name+" failed", "attempt", 3)                                    // want `LOG010` `LOG012`
+	l.Infow("user "+name+" from "+r.Header.Get("X-Real-IP"), "retry", retry) // want `LOG010 untrusted data from webx.Header.Get` `LOG012`
+	l.Infow("User " + name + " signed in!")                                  // want `LOG001` `LOG003` `LOG010` `LOG012`
+
+	msg := "login"
+	if retry {
+		msg = "retry for " + name
+	}
+	l.Infow(msg) // want `LOG010`
+}
@@ -0,0 +1,47 @@
+package interpolation
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const prefix = "user "
+
+func f(ctx context.Context, z *zap.Logger, s *zap.SugaredLogger, id string, ms int, retries int) {
+	slog.Info(fmt.Sprintf("user %s created in %dms", id, ms))  // want `LOG012 log message is built with fmt.Sprintf; pass values as structured attributes \(slog\)`
+	slog.Info("user "+id+" deleted", "reason", "expired")      // want `LOG012 log message is built with string concatenation`
+	slog.InfoContext(ctx, "retry "+id)                         // want `LOG012 log message is built with string concatenation`
+	z.Info(fmt.Sprintf("retrying after %d attempts", retries)) // want `LOG012 log message is built with fmt.Sprintf; pass values as structured attributes \(zap\)`
+	slog.Info(prefix + "created")
+	slog.Info("user created", "user", id)
+	s.Infow(fmt.Sprintf("user %s", id))
+	s.Infof("user %s created", id)
+	log.Print("user " + id)
+}
+
+// Обёртки не принимают атрибуты, и шаблон обёртки не константа: переносить значения некуда.
+func Plain(msg string) { // want Plain:`log wrapper \(slog, message=0, printf=false\)`
+	slog.Info(msg)
+}
+
+func Infof(format string, args ...any) { // want Infof:`log wrapper \(slog, message=0, printf=true\)`
+	slog.Info(fmt.Sprintf(format, args...))
+}
+
+func callers(id string) {
+	Plain("user " + id)
+	Infof("user %s", id)
+}
+
+// Имя атрибута берётся из вызываемой функции или индексируемого значения; у выражения
+// без имени исправления нет.
+func names(next func() string, ids []string, i, a, b int) {
+	slog.Info("next " + next())                        // want `LOG012`
+	slog.Info("user " + ids[i])                        // want `LOG012`
+	slog.Info(fmt.Sprintf("total %d", a+b))            // want `LOG012`
+	slog.Info("user " + func() string { return "" }()) // want `LOG012`
+}
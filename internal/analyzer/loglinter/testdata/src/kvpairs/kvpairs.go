@@ -0,0 +1,57 @@
+package kvpairs
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type Key string
+
+type Alias = string
+
+const keyUser Key = "user"
+
+func pairs(ctx context.Context, l *slog.Logger, id int, v any, key string) {
+	slog.Info("started", "user", id, "orphan") // want `LOG006 key "orphan" has no value \(odd number of key/value arguments\) \(slog\)`
+	slog.Info("started", "user", id)
+	slog.Info("started", id, "x")            // want `LOG006 key must be a string, got int \(slog\)` `LOG006 key "x" has no value`
+	slog.Info("started", errors.New("boom")) // want `LOG006 key must be a string, got error \(slog\)`
+	slog.Info("started", v, 1, 2)
+	slog.InfoContext(ctx, "started", "user", id, key) // want `LOG006 key has no value`
+	l.With("a", 1, "b").Info("ok")                    // want `LOG006 key "b" has no value`
+	args := []any{"a"}
+	slog.Info("started", args...)
+}
+
+func keyTypes(id int) {
+	slog.Info("started", keyUser, id)          // want `LOG006 key must be a string, got Key \(slog\)`
+	slog.Info("started", Key("user"), id)      // want `LOG006 key must be a string, got Key \(slog\)`
+	slog.Info("started", Alias("user"), id)    // алиас — тот же string
+	slog.Info("started", string(keyUser), id)  // явное преобразование к string
+	slog.Info("started", "user", id, "id", id) // нетипизированные константы
+}
+
+func namedSensitiveKey(pw string) {
+	// Ключ именованного типа в лог не попадает (!BADKEY), поэтому LOG004 для него нет.
+	slog.Info("login", Key("password"), pw)                  // want `LOG006 key must be a string, got Key \(slog\)`
+	slog.Info("login", Key("user"), "password", "token", pw) // want `LOG006 key must be a string, got Key \(slog\)` `LOG004 attribute key "token"`
+}
+
+func attrs(ctx context.Context) {
+	slog.Info("started", slog.Int("user", 1), "req", 1)             // want `LOG006 typed attributes are mixed with loose key/value pairs \(slog\)`
+	slog.Info("started", "user", 1, "user", 2)                      // want `LOG006 duplicate key "user" \(slog\)`
+	slog.Info("started", slog.Int("user", 1), slog.Int("user", 2))  // want `LOG006 duplicate key "user"`
+	slog.Info("started", slog.Group("http", "status", 200, "path")) // want `LOG006 key "path" has no value`
+	slog.LogAttrs(ctx, slog.LevelInfo, "started", slog.Int("a", 1), slog.Int("a", 2))
+}
+
+func sugar(s *zap.SugaredLogger) {
+	s.Infow("started", "k1", 1, 2) // want `LOG006 key must be a string, got int \(zap-sugar\)`
+	s.Infow("started", "k1", 1, "k2", 2)
+	s.Infow("started", keyUser, 1)                    // want `LOG006 key must be a string, got Key \(zap-sugar\)`
+	s.Infow("started", zap.String("k", "v"), "k2", 2) // want `LOG006 typed attributes are mixed`
+	s.Infof("started %d", 1)
+}
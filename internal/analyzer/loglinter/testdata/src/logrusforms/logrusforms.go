@@ -0,0 +1,34 @@
+package logrusforms
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+func pkg(tk string) {
+	logrus.Info("Package message")  // want `LOG001 log message must not start with an uppercase letter \(logrus\)`
+	logrus.Warnf("Retry %d", 3)     // want `LOG001`
+	logrus.Errorln("Line message")  // want `LOG001`
+	logrus.Traceln("Trace message") // want `LOG001`
+	logrus.Warningf("token=%s", tk) // want `LOG004`
+	logrus.Print("print message")
+}
+
+func logger(l *logrus.Logger) {
+	l.Debug("Logger message")                // want `LOG001`
+	l.Log(logrus.InfoLevel, "Level message") // want `LOG001`
+	l.Logf(logrus.InfoLevel, "Level %d", 1)  // want `LOG001`
+	l.Panicln("Panic message")               // want `LOG001`
+	l.Fatal("fatal message")
+}
+
+func entries(l *logrus.Logger, err error) {
+	l.WithField("user_id", 1).Info("Entry message")                            // want `LOG001`
+	l.WithFields(logrus.Fields{"user_id": 1}).Warnf("Retry %d", 2)             // want `LOG001`
+	l.WithError(err).WithField("n", 1).Error("Chain message")                  // want `LOG001`
+	logrus.WithError(errors.New("boom")).Logln(logrus.InfoLevel, "Entry line") // want `LOG001`
+	e := logrus.WithField("user_id", 1)
+	e.Info("Stored entry") // want `LOG001`
+	e.Info("stored entry")
+}
@@ -0,0 +1,8 @@
+// Пакет msgcat — каталог сообщений для правила LOG013.
+package msgcat
+
+var UserCreated = "user created"
+
+var ByCode = map[int]string{1: "retry"}
+
+func Text(code int) string { return ByCode[code] }
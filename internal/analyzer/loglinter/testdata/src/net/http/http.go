@@ -0,0 +1,36 @@
+// Пакет http — заглушка net/http для проверки каталога источников по умолчанию.
+package http
+
+import "net/url"
+
+type Header map[string][]string
+
+func (h Header) Get(key string) string      { return "" }
+func (h Header) Values(key string) []string { return nil }
+
+type Cookie struct {
+	Name  string
+	Value string
+}
+
+type File interface {
+	Read(p []byte) (int, error)
+}
+
+type FileHeader struct {
+	Filename string
+}
+
+type Request struct {
+	Header Header
+	URL    *url.URL
+}
+
+func (r *Request) FormValue(key string) string                     { return "" }
+func (r *Request) PostFormValue(key string) string                 { return "" }
+func (r *Request) Cookie(name string) (*Cookie, error)             { return nil, nil }
+func (r *Request) Cookies() []*Cookie                              { return nil }
+func (r *Request) BasicAuth() (username, password string, ok bool) { return "", "", false }
+func (r *Request) UserAgent() string                               { return "" }
+func (r *Request) Referer() string                                 { return "" }
+func (r *Request) FormFile(key string) (File, *FileHeader, error)  { return nil, nil, nil }
@@ -0,0 +1,18 @@
+// Пакет url — заглушка net/url для проверки каталога источников по умолчанию.
+package url
+
+type Values map[string][]string
+
+func (v Values) Get(key string) string { return "" }
+
+type Userinfo struct{}
+
+func (u *Userinfo) Username() string         { return "" }
+func (u *Userinfo) Password() (string, bool) { return "", false }
+
+type URL struct {
+	User     *Userinfo
+	RawQuery string
+}
+
+func (u *URL) Query() Values { return nil }
@@ -0,0 +1,10 @@
+// Package obs — обёртка над логгером, описанная в конфигурации тестов.
+package obs
+
+type L struct{}
+
+var Logger = &L{}
+
+func (l *L) Infow(msg string, kv ...any)       {}
+func (l *L) Debugf(format string, args ...any) {}
+func (l *L) Trace(msg string, kv ...any)       {}
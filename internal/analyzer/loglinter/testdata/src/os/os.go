@@ -0,0 +1,6 @@
+// Пакет os — заглушка os для проверки каталога источников по умолчанию.
+package os
+
+func Getenv(key string) string            { return "" }
+func LookupEnv(key string) (string, bool) { return "", false }
+func Environ() []string                   { return nil }
@@ -0,0 +1,63 @@
+package printfargs
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+type id int
+
+func (id) String() string { return "id" }
+
+func load() error { return errors.New("x") }
+
+func f(s *zap.SugaredLogger, lr *logrus.Logger, name string, n int, d time.Duration, ids []int, p *struct{ A int }) {
+	s.Infof("user %s has %d items", name) // want `LOG015 printf directive %d has no argument: format reads 2 args, but call has 1 \(zap-sugar\)`
+	s.Infof("user %s", name, n)           // want `LOG015 printf call has 2 args, but format reads 1 \(zap-sugar\)`
+	log.Printf("count %d", name)          // want `LOG015 printf directive %d has argument name of wrong type string \(stdlog\)`
+	lr.Infof("took %s, %d ms, %v", d, d, ids)
+	lr.Infof("failed: %w", load()) // want `LOG015 printf directive %w is only supported by fmt.Errorf; use %v \(logrus\)`
+	s.Infof("value %z", n)         // want `LOG015 unknown printf verb 'z' in %z`
+	s.Infof("value %[3]d", n)      // want `LOG015 printf directive %\[3\]d has no argument`
+	s.Infof("%*d", "w", n)         // want `LOG015 printf directive %\*d uses non-int "w" as width or precision`
+	s.Infof("id %s %x %d", id(1), id(2), id(3))
+	s.Infof("ptr %d %v %p", p, p, p)
+	s.Infof("ok %[1]s %[1]q", name)
+
+	err := load()
+	log.Printf("load failed: %s", err) // want `LOG015 error err may be nil here and is printed as %!s\(<nil>\); check it for nil or use %v \(stdlog\)`
+	log.Printf("load result: %v", err)
+	if err != nil {
+		log.Printf("load failed: %s", err)
+	}
+	if err == nil {
+		return
+	}
+	log.Printf("load failed: %s", err)
+}
+
+func g() {
+	if err := load(); err != nil && true {
+		log.Printf("load failed: %s", err)
+	} else {
+		log.Printf("load ok: %s", err) // want `LOG015 error err may be nil`
+	}
+	switch err := load(); {
+	case err != nil:
+		log.Printf("load failed: %s", err)
+	}
+}
+
+// Infof — обёртка: go vet printf её не распознаёт без аннотации, а анализатор находит сам.
+func Infof(format string, args ...any) { // want Infof:`log wrapper \(zap-sugar, message=0, printf=true\)`
+	zap.NewNop().Sugar().Infof(format, args...)
+}
+
+func wrapped(name string) {
+	Infof("user %s has %d items", name) // want `LOG015 printf directive %d has no argument`
+	Infof("user %s", name)
+}
@@ -0,0 +1,26 @@
+package printfverbs
+
+import (
+	"log"
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+func f(z *zap.Logger, s *zap.SugaredLogger, lr *logrus.Logger, id string, n int) {
+	slog.Info("user %s created", id)    // want `LOG014 log message contains printf directive %s, but Info does not format its arguments \(slog\)` `LOG006`
+	z.Info("retry %d", zap.Int("n", n)) // want `LOG014 log message contains printf directive %d, but Info does not format its arguments \(zap\)`
+	s.Info("user %s created", id)       // want `LOG014 .*\(zap-sugar\)`
+	lr.Info("retry %d of %d", n, 3)     // want `LOG014 .*\(logrus\)`
+	log.Print("user %q", id)            // want `LOG014 .*\(stdlog\)`
+	slog.Info("progress 100% done")
+	slog.Info("load is 50%")
+	s.Infof("user %s created", id)
+	slog.Info("rate %v", "rate", n) // want `LOG014`
+}
+
+func pairs(id string, n int) {
+	slog.Info("user %s created", id, "attempt", n)   // want `LOG014` `LOG006`
+	slog.Info("user %s of %d", id, slog.Int("n", n)) // want `LOG014`
+}
@@ -0,0 +1,21 @@
+package ranges
+
+import (
+	"log"
+	"log/slog"
+)
+
+const greet = "hello " +
+	"мир" // want `LOG002` `LOG003`
+
+func f(tk string, n int) {
+	slog.Info("esc\té ok") // want `LOG003`
+	slog.Info(`raw line
+	с кириллицей`) // want `LOG002` `LOG003`
+	slog.Info("first part " +
+		"second Ж part") // want `LOG002` `LOG003`
+	slog.Info(greet)
+	slog.Info("user \x74oken = " + tk) // want `LOG004` `LOG012`
+	slog.Info("Started")               // want `LOG001`
+	log.Printf("%d items 🚀", n)        // want `LOG003`
+}
@@ -0,0 +1,20 @@
+package redactuse
+
+import (
+	"log/slog"
+
+	"secrets"
+)
+
+type wrapper struct {
+	Name string
+	Tok  *secrets.Token
+}
+
+func f(c secrets.Creds, m secrets.Masked, t *secrets.Token, w wrapper, k secrets.Key) {
+	slog.Info("creds", "c", c) // want `LOG008 logged value of type secrets.Creds leaks sensitive field Password through its LogValue method \(slog\)`
+	slog.Info("masked", "m", m)
+	slog.Info("tok", "t", t)               // want `LOG008 .*field Value through its String method`
+	slog.Info("wrapper", slog.Any("w", w)) // want `LOG008 logged value of type wrapper leaks sensitive field Tok.Value through its String method`
+	slog.Info("key", "k", k)               // want `LOG008 .*field Key through its MarshalLogObject`
+}
@@ -0,0 +1,67 @@
+package secretfields
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+type dbConfig struct {
+	Host     string
+	Password string
+}
+
+type config struct {
+	Name string
+	DB   dbConfig
+}
+
+type apiClient struct {
+	URL string
+	Key string `json:"api_key"`
+}
+
+type session struct {
+	ID   string
+	Data []byte `log:"redact"`
+}
+
+type user struct {
+	Name      string
+	HasSecret bool
+}
+
+type safe struct {
+	Token string
+}
+
+func (safe) String() string { return "safe" }
+
+type token struct {
+	Password string
+}
+
+func (t token) LogValue() slog.Value { return slog.StringValue("***") }
+
+type holder struct {
+	Tok token
+}
+
+type node struct {
+	Next *node
+	Val  string
+}
+
+func f(l *slog.Logger, z *zap.Logger, cfg config, c *apiClient, s []session, u user, sf safe, n *node, m map[string]dbConfig) {
+	l.Debug(fmt.Sprintf("%+v", cfg)) // want `LOG007 logged value of type config exposes sensitive field DB.Password \(slog\)` `LOG012`
+	slog.Info("client", "client", c) // want `LOG007 logged value of type \*apiClient exposes sensitive field Key`
+	slog.Info("sessions", "s", s)    // want `LOG007 .*field Data`
+	slog.Info("user", "u", u, "n", n)
+	slog.Info("safe", "sf", sf)
+	slog.Info("cfgs", slog.Any("m", m))    // want `LOG007 logged value of type map\[string\]dbConfig exposes sensitive field Password \(slog\)`
+	z.Info("cfg", zap.Any("cfg", cfg))     // want `LOG007 .*DB.Password \(zap\)`
+	slog.Info("cfg " + fmt.Sprint(cfg.DB)) // want `LOG007 .*field Password` `LOG012`
+	fmt.Println(cfg)
+	slog.Info("holder", "h", holder{}) // тип поля Tok сам скрывает пароль через LogValue
+}
@@ -0,0 +1,60 @@
+package secrets
+
+import (
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+type Creds struct {
+	User     string
+	Password string
+}
+
+func (c Creds) LogValue() slog.Value { // want LogValue:"leaks sensitive field Password"
+	return slog.GroupValue(slog.String("user", c.User), slog.String("password", c.Password)) // want `LOG004` `LOG008 LogValue emits sensitive field c.Password`
+}
+
+type Masked struct {
+	User     string
+	Password string
+}
+
+func (m Masked) LogValue() slog.Value {
+	if m.Password == "" {
+		return slog.StringValue(m.User)
+	}
+	return slog.GroupValue(slog.String("user", m.User), slog.String("pass_mask", strings.Repeat("*", len(m.Password))))
+}
+
+type Token struct {
+	Value string `json:"token"`
+}
+
+func (t *Token) String() string { // want String:"leaks sensitive field Value"
+	return "token " + t.Value // want `LOG008 String emits sensitive field t.Value`
+}
+
+type DSN struct {
+	Host string
+	Auth struct {
+		Secret string
+	}
+}
+
+func (d DSN) Error() string { // want Error:"leaks sensitive field Auth.Secret"
+	return fmt.Sprintf("dsn %s@%s", d.Auth.Secret, d.Host) // want `LOG008 Error emits sensitive field d.Auth.Secret`
+}
+
+type Key struct {
+	ID  string
+	Key []byte `log:"redact"`
+}
+
+func (k Key) MarshalLogObject(enc zapcore.ObjectEncoder) error { // want MarshalLogObject:"leaks sensitive field Key"
+	enc.AddString("id", k.ID)
+	enc.AddString("key", string(k.Key)) // want `LOG008 MarshalLogObject emits sensitive field k.Key`
+	return nil
+}
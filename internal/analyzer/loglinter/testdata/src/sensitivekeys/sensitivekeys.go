@@ -0,0 +1,39 @@
+package sensitivekeys
+
+import (
+	"log/slog"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+)
+
+const keyAPI = "X-Api-Key"
+
+func f(l *slog.Logger, z *zap.Logger, s *zap.SugaredLogger, pw, tok string, pin int) {
+	slog.Info("login", "password", pw) // want `LOG004 attribute key "password" looks sensitive \(slog\)`
+	slog.Info("login", "user", pw)
+	slog.Info("login", slog.String("apiKey", tok))    // want `LOG004 attribute key "apiKey" looks sensitive \(slog\)`
+	slog.Info("login", slog.Int("password_len", pin)) // want `LOG004 attribute key "password_len"`
+	slog.Info("login", slog.String(keyAPI, tok))      // want `LOG004 attribute key "X-Api-Key"`
+	l.With("token", tok).Info("ok")                   // want `LOG004 attribute key "token"`
+	z.Info("auth", zap.String("access_token", tok))   // want `LOG004 attribute key "access_token" looks sensitive \(zap\)`
+	z.With(zap.Any("secret", tok)).Info("auth")       // want `LOG004 attribute key "secret"`
+	s.Infow("auth", "privateKey", tok)                // want `LOG004 attribute key "privateKey" looks sensitive \(zap-sugar\)`
+	logrus.WithField("password", pw).Info("login")    // want `LOG004 attribute key "password" looks sensitive \(logrus\)`
+}
+
+func words(tok string) {
+	slog.Info("login", "api_key", tok)   // want `LOG004 attribute key "api_key"`
+	slog.Info("login", "author", tok)    // слово author не совпадает с auth
+	slog.Info("login", "tokens_used", 3) // слово tokens не совпадает с token
+}
+
+func packageWith(pw string, id int) {
+	slog.With("password", pw).Info("login")       // want `LOG004 attribute key "password" looks sensitive \(slog\)`
+	slog.With("user", id, "orphan").Info("login") // want `LOG006 key "orphan" has no value`
+}
+
+func fields(pw string, id int) {
+	logrus.WithFields(logrus.Fields{"user": id, "password": pw}).Info("login") // want `LOG004 attribute key "password" looks sensitive \(logrus\)`
+	logrus.WithFields(logrus.Fields{"apiKey": pw}).Info("login")               // want `LOG004 attribute key "apiKey"`
+}
@@ -0,0 +1,32 @@
+package slogforms
+
+import (
+	"context"
+	"log/slog"
+)
+
+func calls(ctx context.Context, l *slog.Logger) {
+	slog.DebugContext(ctx, "Debug message") // want `LOG001 log message must not start with an uppercase letter \(slog\)`
+	slog.InfoContext(ctx, "Info message")   // want `LOG001`
+	slog.WarnContext(ctx, "Warn message")   // want `LOG001`
+	slog.ErrorContext(ctx, "Error message") // want `LOG001`
+
+	l.DebugContext(ctx, "Debug message") // want `LOG001`
+	l.InfoContext(ctx, "Info message")   // want `LOG001`
+	l.WarnContext(ctx, "Warn message")   // want `LOG001`
+	l.ErrorContext(ctx, "Error message") // want `LOG001`
+
+	slog.Log(ctx, slog.LevelInfo, "Log message")                          // want `LOG001`
+	l.Log(ctx, slog.LevelWarn, "Log message")                             // want `LOG001`
+	slog.LogAttrs(ctx, slog.LevelInfo, "Attrs message", slog.Int("n", 1)) // want `LOG001`
+	l.LogAttrs(ctx, slog.LevelError, "Attrs message")                     // want `LOG001`
+
+	l.Log(ctx, slog.LevelInfo, "done 🚀") // want `LOG003`
+}
+
+func valid(ctx context.Context, l *slog.Logger) {
+	slog.InfoContext(ctx, "info message")
+	l.Log(ctx, slog.LevelInfo, "log message", "user_id", 1)
+	l.LogAttrs(ctx, slog.LevelInfo, "attrs message", slog.String("user_id", "1"))
+	slog.Log(ctx, slog.LevelInfo, "")
+}
@@ -0,0 +1,78 @@
+// Пакет проверяется с включённым SSA. Стандартная библиотека не импортируется: анализатор с
+// фактами запускается и на зависимостях, а SSA строится для каждой из них.
+package ssavars
+
+import (
+	"go.uber.org/zap"
+
+	"obs"
+)
+
+func branches(retry bool) {
+	msg := "User Created" // want `LOG001`
+	if retry {
+		msg = "User Retried!" // want `LOG001` `LOG003`
+	}
+	obs.Logger.Infow(msg)
+
+	var other string
+	if retry {
+		other = "retry ✨" // want `LOG003`
+	} else {
+		other = "ok"
+	}
+	obs.Logger.Infow(other)
+}
+
+func reassignment() {
+	msg := "Overwritten"
+	msg = "fine"
+	obs.Logger.Infow(msg)
+
+	var m2 = "Unused message"
+	m2 = "Used message" // want `LOG001`
+	obs.Logger.Infow(m2)
+}
+
+func duplicates(retry bool) {
+	// Одинаковый текст в нескольких присваиваниях: константа в SSA не знает, откуда она,
+	// поэтому перезаписанные присваивания отбрасываются.
+	a := "Dup"
+	a = "other"
+	a = "Dup" // want `LOG001`
+	obs.Logger.Infow(a)
+
+	b := "Twice" // want `LOG001`
+	if retry {
+		b = "Twice" // want `LOG001`
+	}
+	obs.Logger.Infow(b)
+
+	c := "Before" // want `LOG001`
+	obs.Logger.Infow(c)
+	c = "Before"
+	_ = c
+}
+
+func computed(tk string, retry bool) {
+	msg := "ok"
+	if retry {
+		msg = "token=" + tk // want `LOG004`
+	}
+	obs.Logger.Infow(msg)
+	obs.Logger.Infow(tk)
+}
+
+func loops(n int) {
+	msg := "start"
+	for i := 0; i < n; i++ {
+		obs.Logger.Infow(msg)
+		msg = "Again" // want `LOG001`
+	}
+}
+
+func variadic(s *zap.SugaredLogger) {
+	// Сообщение Info(args ...any) лежит в срезе вариативного параметра.
+	msg := "Sugared message" // want `LOG001`
+	s.Info(msg)
+}
@@ -0,0 +1,71 @@
+package staticmsg
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"log/slog"
+
+	"msgcat"
+
+	"go.uber.org/zap"
+)
+
+const started = "service started"
+
+func build(x int) string { return "x" }
+
+func f(z *zap.Logger, s *zap.SugaredLogger, msg string, err error, retry bool, code int) {
+	slog.Info(msg)         // want `LOG013 log message is computed at runtime; use a constant message and pass values as attributes \(slog\)`
+	slog.Info(err.Error()) // want `LOG013 log message is computed at runtime`
+	z.Warn(build(1))       // want `LOG013 .*\(zap\)`
+	s.Infof(msg, 1)        // want `LOG013 .*\(zap-sugar\)`
+	log.Print(msg)         // want `LOG013 .*\(stdlog\)`
+	slog.Info(started)
+	slog.Info(msgcat.UserCreated)
+	slog.Info(msgcat.ByCode[code])
+	slog.Info(msgcat.Text(code))
+	slog.Info(string(started))
+	slog.Info(errors.New(started).Error()) // текст ошибки известен
+}
+
+func parts(tk string, code int) {
+	slog.Info("token=" + tk)                                     // want `LOG004` `LOG013` `LOG012`
+	slog.Info(fmt.Sprintf("user %s created", tk))                // want `LOG013` `LOG012`
+	slog.Info("user " + msgcat.UserCreated)                      // want `LOG012`
+	slog.Info(fmt.Sprintf("%s: %s", started, msgcat.Text(code))) // want `LOG012`
+	slog.Info(started + " " + msgcat.UserCreated + tk)           // want `LOG013` `LOG012`
+}
+
+func vars(msg string, retry bool) {
+	m := "request done"
+	if retry {
+		m = "request retried"
+	}
+	slog.Info(m)
+
+	d := "done"
+	if retry {
+		d = msg
+	}
+	slog.Info(d) // want `LOG013`
+
+	p := "retry " + msg
+	slog.Info(p) // want `LOG013`
+}
+
+// В теле обёртки сообщение — её параметр: постоянство проверяется на вызовах обёртки.
+func Plain(msg string) { // want Plain:`log wrapper \(slog, message=0, printf=false\)`
+	slog.Info(msg)
+}
+
+func Infof(format string, args ...any) { // want Infof:`log wrapper \(slog, message=0, printf=true\)`
+	slog.Info(fmt.Sprintf(format, args...))
+}
+
+func callers(msg string) {
+	Plain(started)
+	Plain(msg) // want `LOG013 .*\(slog\)`
+	Infof("user %s created", msg)
+	Infof(msg, 1) // want `LOG013`
+}
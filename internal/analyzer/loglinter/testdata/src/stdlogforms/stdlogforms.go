@@ -0,0 +1,27 @@
+package stdlogforms
+
+import (
+	"log"
+	"os"
+)
+
+func pkg(host string) {
+	log.Print("Starting server")        // want `LOG001 log message must not start with an uppercase letter \(stdlog\)`
+	log.Printf("Connected to %s", host) // want `LOG001`
+	log.Println("Listening")            // want `LOG001`
+	log.Fatal("Fatal error")            // want `LOG001`
+	log.Fatalf("Bad host %s", host)     // want `LOG001`
+	log.Fatalln("Exiting")              // want `LOG001`
+	log.Panic("Panic error")            // want `LOG001`
+	log.Panicf("Bad host %s", host)     // want `LOG001`
+	log.Panicln("Exiting")              // want `LOG001`
+	log.Println("listening")
+}
+
+func logger(host, tk string) {
+	l := log.New(os.Stderr, "app: ", log.LstdFlags)
+	l.Printf("Connected to %s", host)     // want `LOG001`
+	l.Fatalf("token=%s for %s", tk, host) // want `LOG004`
+	l.Panicln("Boom")                     // want `LOG001`
+	l.Print("connected")
+}
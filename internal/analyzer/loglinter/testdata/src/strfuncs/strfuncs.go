@@ -0,0 +1,49 @@
+package strfuncs
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+type id string
+
+func calls(tk, h, name string, n, m int, v any) {
+	slog.Info(fmt.Sprint("Token: ", tk))                               // want `LOG001` `LOG004` `LOG012`
+	slog.Info(strings.Join([]string{"Authorization: Bearer", h}, " ")) // want `LOG001` `LOG004`
+	logrus.Error(errors.New("Failed!"))                                // want `LOG001` `LOG003`
+	slog.Info(fmt.Errorf("Failed %d", n).Error())                      // want `LOG001`
+	slog.Info(fmt.Sprintln("Hello", name, "!"))                        // want `LOG001` `LOG003` `LOG012`
+	slog.Info(fmt.Sprintf("Retry %d of %d", n, m))                     // want `LOG001` `LOG012`
+	slog.Info(strings.Join([]string{"first", "Second ✨"}, ", "))       // want `LOG003`
+	slog.Info(fmt.Sprint("user ", name, " done"))                      // want `LOG012`
+	slog.Info(fmt.Sprint(n))                                           // want `LOG012`
+}
+
+// fmt.Sprint ставит пробел только между операндами, которые не являются строками.
+func sprintSpacing(n, m int, s id, v any) {
+	slog.Info(fmt.Sprint(n, m, "items ✨")) // want `LOG003` `LOG012`
+	slog.Info(fmt.Sprint(s, n, "items"))   // want `LOG012`
+	slog.Info(fmt.Sprint(v, n, "items 🚀")) // want `LOG003` `LOG012`
+	slog.Info(fmt.Sprint(n, m, "items"))   // want `LOG012`
+}
+
+func builders(tk string) {
+	var b strings.Builder
+	b.WriteString("Secret: ") // want `LOG001` `LOG004`
+	b.WriteString(tk)
+	b.WriteByte('!') // want `LOG003`
+	slog.Info(b.String())
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "token=%s", tk) // want `LOG004`
+	slog.Info(sb.String())
+
+	var ok strings.Builder
+	ok.WriteString("request ")
+	fmt.Fprint(&ok, 1, 2)
+	slog.Info(ok.String())
+}
@@ -0,0 +1,57 @@
+package taintdefaults
+
+import (
+	"flag"
+	"net/http"
+	"net/url"
+	"obs"
+	"os"
+)
+
+func request(r *http.Request) {
+	obs.Logger.Infow("form", r.FormValue("name"))           // want `LOG009 log argument carries untrusted data from http.Request.FormValue \(obs\)`
+	obs.Logger.Infow("post", r.PostFormValue("name"))       // want `LOG009 .*http.Request.PostFormValue`
+	obs.Logger.Infow("agent", r.UserAgent())                // want `LOG009 .*http.Request.UserAgent`
+	obs.Logger.Infow("referer", r.Referer())                // want `LOG009 .*http.Request.Referer`
+	obs.Logger.Infow("cookies", r.Cookies())                // want `LOG009 .*http.Request.Cookies` `LOG011 .*cookies carry session tokens`
+	obs.Logger.Infow("auth", r.Header.Get("Authorization")) // want `LOG009 .*http.Header.Get`
+	obs.Logger.Infow("accept", r.Header.Values("Accept"))   // want `LOG009 .*http.Header.Values`
+
+	c, _ := r.Cookie("session")
+	obs.Logger.Infow("cookie", c.Value) // want `LOG009 .*http.Request.Cookie`
+	user, _, _ := r.BasicAuth()
+	obs.Logger.Infow("user", user) // want `LOG009 .*http.Request.BasicAuth`
+	_, fh, _ := r.FormFile("upload")
+	obs.Logger.Infow("file", fh.Filename) // want `LOG009 .*http.Request.FormFile`
+}
+
+func query(r *http.Request) {
+	q := r.URL.Query()
+	obs.Logger.Infow("q", q)                        // want `LOG009 .*url.URL.Query`
+	obs.Logger.Infow("id", q["id"][0])              // want `LOG009 .*url.URL.Query`
+	obs.Logger.Infow("name", r.URL.User.Username()) // want `LOG009 .*url.Userinfo.Username`
+	pw, _ := r.URL.User.Password()
+	obs.Logger.Infow("pw", pw) // want `LOG009 .*url.Userinfo.Password`
+}
+
+func values(v url.Values) {
+	obs.Logger.Infow("page", v.Get("page")) // want `LOG009 .*url.Values.Get`
+}
+
+func env() {
+	obs.Logger.Infow("home", os.Getenv("HOME")) // want `LOG009 log argument carries untrusted data from os.Getenv \(obs\)`
+	token, _ := os.LookupEnv("TOKEN")
+	obs.Logger.Infow("token", token)      // want `LOG009 .*os.LookupEnv`
+	obs.Logger.Infow("env", os.Environ()) // want `LOG009 .*os.Environ` `LOG011 .*environment contains secrets`
+}
+
+func flags(fs *flag.FlagSet) {
+	obs.Logger.Infow("name", *flag.String("name", "", "")) // want `LOG009 .*flag.String`
+	obs.Logger.Infow("arg", flag.Arg(0))                   // want `LOG009 .*flag.Arg`
+	obs.Logger.Infow("args", flag.Args())                  // want `LOG009 .*flag.Args`
+	obs.Logger.Infow("flag", flag.Lookup("v"))             // want `LOG009 .*flag.Lookup`
+	obs.Logger.Infow("name", *fs.String("name", "", ""))   // want `LOG009 .*flag.FlagSet.String`
+	obs.Logger.Infow("arg", fs.Arg(0))                     // want `LOG009 .*flag.FlagSet.Arg`
+	obs.Logger.Infow("args", fs.Args())                    // want `LOG009 .*flag.FlagSet.Args`
+	obs.Logger.Infow("flag", fs.Lookup("v"))               // want `LOG009 .*flag.FlagSet.Lookup`
+}
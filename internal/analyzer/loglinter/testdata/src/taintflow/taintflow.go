@@ -0,0 +1,49 @@
+package taintflow
+
+import (
+	"obs"
+	"webx"
+)
+
+type creds struct {
+	user string
+	pass string
+}
+
+func f(r *webx.Request, retry bool) {
+	auth := r.Header.Get("Authorization")
+	obs.Logger.Infow("auth", auth) // want `LOG009 log argument carries untrusted data from webx.Header.Get \(obs\)`
+
+	pw := webx.Getenv("DB_PASSWORD")
+	msg := "connecting"
+	if retry {
+		msg = webx.Join("retry with ", pw)
+	}
+	obs.Logger.Infow(msg) // want `LOG010 untrusted data from webx.Getenv`
+
+	obs.Logger.Infow("hash", webx.Hash(pw))
+	obs.Logger.Debugf("n=%d", len(pw))
+
+	var c creds
+	c.pass = r.FormValue("password")
+	obs.Logger.Infow("creds", &c) // want `LOG009 .*webx.Request.FormValue`
+
+	webx.Audit("login", pw)                           // want `LOG009 log argument carries untrusted data from webx.Getenv \(webx.Audit\)`
+	obs.Logger.Infow("user", r.FormValue("user")+"!") // want `LOG009`
+}
+
+func negatives(r *webx.Request, n int) {
+	name := r.FormValue("name")
+	obs.Logger.Infow("quoted", webx.Quote(name)) // want `LOG009`
+	obs.Logger.Infow("count", n)
+	obs.Logger.Infow("static " + "text")
+
+	safe := webx.Hash(r.Header.Get("Authorization"))
+	obs.Logger.Infow("hashed", safe)
+}
+
+func through(r *webx.Request) {
+	obs.Logger.Infow("forwarded", relay(r.Header.Get("X-Token"))) // want `LOG009 .*webx.Header.Get`
+}
+
+func relay(s string) string { return s }
@@ -0,0 +1,25 @@
+// Пакет webx — заглушка HTTP-слоя для тестов taint-анализа: источники, санитайзер, экранирующая
+// функция и собственный приёмник.
+package webx
+
+type Header map[string][]string
+
+func (h Header) Get(key string) string { return "" }
+
+type Request struct {
+	Header Header
+}
+
+func (r *Request) FormValue(key string) string { return "" }
+
+func Getenv(key string) string { return "" }
+
+func Hash(s string) string { return "" }
+
+func Join(a, b string) string { return a + b }
+
+func Audit(args ...any) {}
+
+func Quote(s string) string { return s }
+
+func Sprintf(format string, args ...any) string { return format }
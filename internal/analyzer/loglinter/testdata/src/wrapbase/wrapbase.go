@@ -0,0 +1,51 @@
+// Package wrapbase объявляет обёртки над логгерами, которые распознаются автоматически.
+package wrapbase
+
+import (
+	"fmt"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func Infof(format string, args ...any) { // want Infof:`log wrapper \(slog, message=0, printf=true\)`
+	slog.Info(fmt.Sprintf(format, args...))
+}
+
+func Errorf(format string, args ...any) { // want Errorf:`log wrapper \(zap-sugar, message=0, printf=true\)`
+	zap.NewNop().Sugar().Errorf(format, args...)
+}
+
+func Info(msg string, kv ...any) { // want Info:`log wrapper \(slog, message=0, printf=false\)`
+	slog.Info(msg, kv...)
+}
+
+// Outer и middle — обёртки над обёрткой из этого же пакета.
+func Outer(level int, format string, args ...any) { // want Outer:`log wrapper \(slog, message=1, printf=true\)`
+	middle(format, args...)
+}
+
+func middle(format string, args ...any) { // want middle:`log wrapper \(slog, message=0, printf=true\)`
+	Infof(format, args...)
+}
+
+type Log struct{ s *zap.SugaredLogger }
+
+func (l *Log) Warn(msg string) { // want Warn:`log wrapper \(zap-sugar, message=0, printf=false\)`
+	l.s.Infow(msg, "component", "base")
+}
+
+// Generic — обёртка с параметром типа.
+func Generic[T any](msg string, v T) { // want Generic:`log wrapper \(slog, message=0, printf=false\)`
+	slog.Info(msg, "value", v)
+}
+
+// NotWrapper логирует свой параметр среди других инструкций и не передаёт аргументы дальше.
+func NotWrapper(name string, n int) {
+	n++
+	slog.Info(name, "n", n)
+}
+
+func Fixed(n int) {
+	slog.Info("fixed message", "n", n)
+}
@@ -0,0 +1,20 @@
+// Package wrapmid оборачивает обёртки из wrapbase: факты доходят до него через импорт.
+package wrapmid
+
+import "wrapbase"
+
+func Logf(format string, args ...any) { // want Logf:`log wrapper \(slog, message=0, printf=true\)`
+	wrapbase.Infof(format, args...)
+}
+
+func Fail(ctx any, format string, args ...any) { // want Fail:`log wrapper \(zap-sugar, message=1, printf=true\)`
+	wrapbase.Errorf(format, args...)
+}
+
+func Event(msg string) { // want Event:`log wrapper \(slog, message=0, printf=false\)`
+	wrapbase.Info(msg)
+}
+
+func local() {
+	wrapbase.Infof("Local message %d", 1) // want `LOG001 .*\(slog\)`
+}
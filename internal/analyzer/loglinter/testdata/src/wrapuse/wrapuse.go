@@ -0,0 +1,25 @@
+package wrapuse
+
+import (
+	"wrapbase"
+	"wrapmid"
+)
+
+func direct(l *wrapbase.Log, tk string) {
+	wrapbase.Infof("Started %d", 1)   // want `LOG001 log message must not start with an uppercase letter \(slog\)`
+	wrapbase.Info("Started", "n", 1)  // want `LOG001`
+	wrapbase.Outer(1, "token=%s", tk) // want `LOG004`
+	l.Warn("Warned")                  // want `LOG001 .*\(zap-sugar\)`
+	wrapbase.Generic("Generic", 1)    // want `LOG001`
+	wrapbase.Generic[string]("ok", "")
+	wrapbase.NotWrapper("Not a message", 1)
+	wrapbase.Fixed(1)
+}
+
+func twoLevel(tk string) {
+	wrapmid.Logf("Two levels %d", 2)   // want `LOG001 .*\(slog\)`
+	wrapmid.Fail(nil, "Failed %s", tk) // want `LOG001 .*\(zap-sugar\)`
+	wrapmid.Event("event событие")     // want `LOG002` `LOG003`
+	wrapmid.Logf("api_key=%s", tk)     // want `LOG004`
+	wrapmid.Logf("started %d", 2)
+}
@@ -0,0 +1,41 @@
+package zapforms
+
+import (
+	"go.uber.org/zap"
+)
+
+func logger(l *zap.Logger) {
+	l.Debug("Debug message")      // want `LOG001 log message must not start with an uppercase letter \(zap\)`
+	l.Info("Info message")        // want `LOG001`
+	l.Warn("Warn message")        // want `LOG001`
+	l.Error("Error message")      // want `LOG001`
+	l.DPanic("Development panic") // want `LOG001`
+	l.Panic("Panic message")      // want `LOG001`
+	l.Fatal("Fatal message")      // want `LOG001`
+
+	l.Log(zap.InfoLevel, "Log message")               // want `LOG001`
+	l.Check(zap.InfoLevel, "Checked message").Write() // want `LOG001`
+	l.With(zap.Int("n", 1)).Info("Chained message")   // want `LOG001`
+	l.Info("request done", zap.String("path", "/"))
+	if ce := l.Check(zap.InfoLevel, "checked message"); ce != nil {
+		ce.Write(zap.Int("n", 1))
+	}
+}
+
+func sugared(l *zap.Logger, tk string) {
+	s := l.Sugar()
+	s.Info("Plain message")                // want `LOG001 log message must not start with an uppercase letter \(zap-sugar\)`
+	s.Infof("Retry %d", 3)                 // want `LOG001`
+	s.Warnw("Warn message", "n", 1)        // want `LOG001`
+	s.Errorln("Line message")              // want `LOG001`
+	s.DPanicf("Panic %s", "now")           // want `LOG001`
+	s.Fatalw("Fatal message")              // want `LOG001`
+	s.Panicln("Panic message")             // want `LOG001`
+	s.Logf(zap.InfoLevel, "Level %d", 1)   // want `LOG001`
+	s.Logw(zap.InfoLevel, "Level message") // want `LOG001`
+	s.Logln(zap.InfoLevel, "Level line")   // want `LOG001`
+
+	s.Infof("token=%s", tk) // want `LOG004`
+	s.Debugf("retry %d", 3)
+	s.Infow("user created", "user_id", 1)
+}
@@ -0,0 +1,25 @@
+package zerologforms
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func chains(l zerolog.Logger, err error, tk string) {
+	log.Info().Str("user", "x").Msg("Started")            // want `LOG001 log message must not start with an uppercase letter \(zerolog\)`
+	log.Err(err).Msgf("Failed %d", 1)                     // want `LOG001`
+	log.WithLevel(zerolog.InfoLevel).Msg("Level message") // want `LOG001`
+	l.Warn().Int("n", 1).Bool("ok", true).Msg("Warning")  // want `LOG001`
+	l.Info().Msgf("token=%s", tk)                         // want `LOG004`
+	l.Debug().Str("user_id", "1").Msg("user loaded")
+
+	ev := l.Info()
+	ev.Msg("Detached event") // want `LOG001`
+}
+
+func fieldKeys(l zerolog.Logger, tk string) {
+	l.Info().Str("token", tk).Int("n", 1).Msg("ok") // want `LOG004 attribute key "token" looks sensitive \(zerolog\)`
+	log.Error().Str("secret", tk).Send()            // want `LOG004 attribute key "secret" looks sensitive \(zerolog\)`
+	log.Info().Interface("api_key", tk).Send()      // want `LOG004 attribute key "api_key" looks sensitive \(zerolog\)`
+	log.Info().Str("user", tk).Send()
+}
@@ -0,0 +1,153 @@
+package loglinter
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/iconfire7/loglintergo/internal/printf"
+	"github.com/iconfire7/loglintergo/internal/rules"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// messageDirectives возвращает директивы printf в сообщении, которое не является шаблоном.
+// Учитываются только директивы с глаголом fmt без флага-пробела, чтобы текст вроде
+// "100% done" не считался шаблоном.
+func messageDirectives(text string) []printf.Directive {
+	var out []printf.Directive
+	for _, d := range printf.Directives(text) {
+		if d.Err == "" && printf.KnownVerb(d.Verb) && !strings.Contains(d.Flags, " ") {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// checkPrintfVerbs сообщает о директивах printf в сообщении метода, который не форматирует
+// аргументы: slog.Info("user %s created", id) выводит "%s" как есть, а id становится
+// ключом без значения.
+func (l *linter) checkPrintfVerbs(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr, report func(analysis.Diagnostic)) {
+	if lc.printf {
+		return
+	}
+	parts := messageParts(pass, msgExpr)
+	text, ok := joinStatic(parts)
+	if !ok || hasDynamicPart(parts) {
+		return
+	}
+	dirs := messageDirectives(text)
+	if len(dirs) == 0 {
+		return
+	}
+
+	name := "method"
+	if fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func); ok {
+		name = fn.Name()
+	}
+	diag := analysis.Diagnostic{
+		Pos: msgExpr.Pos(),
+		End: msgExpr.End(),
+		Message: string(rules.RPrintfVerbs) + " log message contains printf directive " + dirs[0].Text +
+			", but " + name + " does not format its arguments (" + lc.kind + ")",
+	}
+	if fix, ok := l.printfVerbsFix(pass, call, lc, msgExpr, text, dirs); ok {
+		diag.SuggestedFixes = []analysis.SuggestedFix{fix}
+	}
+	report(diag)
+}
+
+// printfVerbsFix переводит вызов на printf-вариант метода (Info -> Infof у zap sugar, logrus,
+// log и Msg -> Msgf у zerolog), если число аргументов совпадает с шаблоном. Для вызовов
+// с парами ключ/значение значения директив становятся атрибутами, а для вызовов с готовыми
+// атрибутами директивы удаляются из текста.
+func (l *linter) printfVerbsFix(pass *analysis.Pass, call *ast.CallExpr, lc loggerCall, msgExpr ast.Expr, text string, dirs []printf.Directive) (analysis.SuggestedFix, bool) {
+	if call.Ellipsis.IsValid() {
+		return analysis.SuggestedFix{}, false
+	}
+	values := call.Args[lc.msgIdx+1:]
+	n := printf.ArgCount(dirs)
+
+	if !lc.kv && !lc.fields {
+		sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+		variant, found := formatVariant(pass, call)
+		if !ok || !found || n != len(values) {
+			return analysis.SuggestedFix{}, false
+		}
+		return analysis.SuggestedFix{
+			Message:   "use " + variant,
+			TextEdits: []analysis.TextEdit{{Pos: sel.Sel.Pos(), End: sel.Sel.End(), NewText: []byte(variant)}},
+		}, true
+	}
+
+	if lit, ok := ast.Unparen(msgExpr).(*ast.BasicLit); !ok || lit.Kind != token.STRING {
+		return analysis.SuggestedFix{}, false
+	}
+	static, ok := structuredText([]msgPart{{text: text, static: true, format: true}})
+	if !ok {
+		return analysis.SuggestedFix{}, false
+	}
+	edits := []analysis.TextEdit{{Pos: msgExpr.Pos(), End: msgExpr.End(), NewText: []byte(strconv.Quote(static))}}
+	if lc.fields {
+		return analysis.SuggestedFix{Message: "remove printf directives from log message", TextEdits: edits}, true
+	}
+
+	// Пары ключ/значение: первые n аргументов — значения директив, им нужны ключи.
+	for i, d := range dirs {
+		if d.Indexed || d.WidthArg >= 0 || d.PrecArg >= 0 || d.Arg != i {
+			return analysis.SuggestedFix{}, false
+		}
+	}
+	if n > len(values) {
+		return analysis.SuggestedFix{}, false
+	}
+	// Остальные аргументы уже должны составлять пары: иначе ключ получило бы не то значение
+	// (slog.Info("rate %v", "rate", n)).
+	loose := 0
+	for _, v := range values[n:] {
+		if t := pass.TypesInfo.TypeOf(v); t == nil || !isAttrValue(t) {
+			loose++
+		}
+	}
+	if loose%2 != 0 {
+		return analysis.SuggestedFix{}, false
+	}
+	used := map[string]bool{}
+	for _, v := range values[:n] {
+		if t := pass.TypesInfo.TypeOf(v); t == nil || isAttrValue(t) {
+			return analysis.SuggestedFix{}, false
+		}
+		key := l.uniqueAttrName(attrName(pass, v), used)
+		edits = append(edits, analysis.TextEdit{Pos: v.Pos(), End: v.Pos(), NewText: []byte(strconv.Quote(key) + ", ")})
+	}
+	return analysis.SuggestedFix{Message: "move values into structured attributes", TextEdits: edits}, true
+}
+
+// formatVariant возвращает имя printf-варианта вызванного метода встроенного логгера:
+// Info -> Infof, Print -> Printf, Msg -> Msgf.
+func formatVariant(pass *analysis.Pass, call *ast.CallExpr) (string, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok || fn.Pkg() == nil {
+		return "", false
+	}
+	var k loggerKind
+	if recv := fn.Type().(*types.Signature).Recv(); recv != nil {
+		named := derefNamed(recv.Type())
+		if named == nil || named.Obj().Pkg() == nil {
+			return "", false
+		}
+		k, ok = loggerTypes[loggerType{named.Obj().Pkg().Path(), named.Obj().Name()}]
+	} else {
+		k, ok = loggerPackages[fn.Pkg().Path()]
+	}
+	if !ok {
+		return "", false
+	}
+	variant := fn.Name() + "f"
+	if m, ok := k.methods[variant]; !ok || !m.printf || m.msgIdx != k.methods[fn.Name()].msgIdx {
+		return "", false
+	}
+	return variant, true
+}
@@ -0,0 +1,163 @@
+package loglinter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// wrapperFact помечает функцию-обёртку, которая передаёт свой параметр в сообщение логгера.
+// Факт экспортируется, поэтому вызовы обёртки распознаются и в пакетах, которые её импортируют.
+type wrapperFact struct {
+	Kind   string // вид логгера, до которого в итоге доходит сообщение
+	MsgIdx int    // индекс параметра обёртки, который становится сообщением
+	Printf bool   // параметр — printf-шаблон, а вариативный параметр — его аргументы
+}
+
+func (*wrapperFact) AFact() {}
+
+func (f *wrapperFact) String() string {
+	return fmt.Sprintf("log wrapper (%s, message=%d, printf=%t)", f.Kind, f.MsgIdx, f.Printf)
+}
+
+// exportWrapperFacts находит в пакете обёртки над логгерами и экспортирует для них wrapperFact.
+// Обход повторяется, пока находятся новые обёртки: так обёртки над обёртками из этого же
+// пакета распознаются независимо от порядка объявления.
+func exportWrapperFacts(pass *analysis.Pass, d *detector) {
+	var decls []*ast.FuncDecl
+	for _, f := range pass.Files {
+		for _, decl := range f.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok && fd.Body != nil {
+				decls = append(decls, fd)
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, fd := range decls {
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok || pass.ImportObjectFact(fn, new(wrapperFact)) {
+				continue
+			}
+			if fact, ok := wrapperOf(pass, d, fd, fn); ok {
+				pass.ExportObjectFact(fn, fact)
+				changed = true
+			}
+		}
+	}
+}
+
+// wrapperOf проверяет, передаёт ли функция fn один из своих строковых параметров
+// в сообщение вызова логгера — напрямую или как шаблон fmt.Sprintf. Чтобы не считать
+// обёрткой любую функцию, которая логирует свой аргумент, требуется, как и в go vet printf,
+// чтобы вариативный параметр тоже передавался дальше (args...), либо чтобы вызов логгера
+// был единственной инструкцией функции.
+func wrapperOf(pass *analysis.Pass, d *detector, fd *ast.FuncDecl, fn *types.Func) (*wrapperFact, bool) {
+	sig := fn.Type().(*types.Signature)
+
+	var fact *wrapperFact
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if fact != nil {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		lc, ok := d.detectLoggerCall(pass, call)
+		if !ok {
+			return true
+		}
+		msgExpr, ok := lc.msgArg(call)
+		if !ok {
+			return true
+		}
+		msgExpr = ast.Unparen(msgExpr)
+
+		// Infof(format string, args ...any) { sugar.Infof(format, args...) }
+		// Info(msg string) { slog.Info(msg) }
+		if idx, ok := stringParamIndex(pass, sig, msgExpr); ok {
+			forwards := forwardsVariadic(pass, sig, call)
+			if !forwards && !isSoleStatement(fd.Body, call) {
+				return true
+			}
+			fact = &wrapperFact{Kind: lc.kind, MsgIdx: idx, Printf: lc.printf && forwards}
+			return false
+		}
+
+		// Infof(format string, args ...any) { slog.Info(fmt.Sprintf(format, args...)) }
+		if inner, ok := msgExpr.(*ast.CallExpr); ok && len(inner.Args) > 0 {
+			if sf, ok := lookupStringFunc(pass, inner); !ok || sf.kind != sfFormat {
+				return true
+			}
+			if idx, ok := stringParamIndex(pass, sig, ast.Unparen(inner.Args[0])); ok && forwardsVariadic(pass, sig, inner) {
+				fact = &wrapperFact{Kind: lc.kind, MsgIdx: idx, Printf: true}
+				return false
+			}
+		}
+		return true
+	})
+	return fact, fact != nil
+}
+
+// stringParamIndex возвращает индекс параметра sig, на который ссылается expr,
+// если этот параметр строковый.
+func stringParamIndex(pass *analysis.Pass, sig *types.Signature, expr ast.Expr) (int, bool) {
+	id, ok := expr.(*ast.Ident)
+	if !ok {
+		return 0, false
+	}
+	obj := pass.TypesInfo.Uses[id]
+	if obj == nil {
+		return 0, false
+	}
+	for i := 0; i < sig.Params().Len(); i++ {
+		p := sig.Params().At(i)
+		if p != obj {
+			continue
+		}
+		if b, ok := p.Type().Underlying().(*types.Basic); ok && b.Info()&types.IsString != 0 {
+			return i, true
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+// forwardsVariadic сообщает, что call передаёт вариативный параметр sig дальше как args...
+func forwardsVariadic(pass *analysis.Pass, sig *types.Signature, call *ast.CallExpr) bool {
+	if !sig.Variadic() || !call.Ellipsis.IsValid() || len(call.Args) == 0 {
+		return false
+	}
+	id, ok := ast.Unparen(call.Args[len(call.Args)-1]).(*ast.Ident)
+	if !ok {
+		return false
+	}
+	return pass.TypesInfo.Uses[id] == sig.Params().At(sig.Params().Len()-1)
+}
+
+// isSoleStatement сообщает, что тело функции состоит из одного вызова call.
+func isSoleStatement(body *ast.BlockStmt, call *ast.CallExpr) bool {
+	if len(body.List) != 1 {
+		return false
+	}
+	stmt, ok := body.List[0].(*ast.ExprStmt)
+	return ok && ast.Unparen(stmt.X) == call
+}
+
+// wrapperCall распознаёт вызов функции, помеченной wrapperFact.
+func wrapperCall(pass *analysis.Pass, call *ast.CallExpr) (loggerCall, bool) {
+	fn, ok := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	if !ok {
+		return loggerCall{}, false
+	}
+	var fact wrapperFact
+	if !pass.ImportObjectFact(fn.Origin(), &fact) {
+		return loggerCall{}, false
+	}
+	return loggerCall{kind: fact.Kind, loggerMethod: loggerMethod{msgIdx: fact.MsgIdx, printf: fact.Printf}}, true
+}
@@ -3,22 +3,105 @@ package config
 type Config struct {
 	Rules             Rules    `mapstructure:"rules"`
 	SensitivePatterns []string `mapstructure:"sensitive_patterns"`
+	// SensitiveKeys — запрещённые ключи атрибутов для правила sensitive; сравниваются по словам,
+	// поэтому api_key совпадает с apiKey и X-Api-Key.
+	SensitiveKeys []string `mapstructure:"sensitive_keys"`
+	Loggers       []Logger `mapstructure:"loggers"`
+	// SSA включает анализ значений, которые доходят до сообщения через переменные.
+	SSA bool `mapstructure:"ssa"`
+	// AttrKeyStyle — соглашение об именовании ключей атрибутов для правила attr_keys:
+	// snake_case, camelCase, kebab-case или regex (тогда используется AttrKeyPattern).
+	AttrKeyStyle   string `mapstructure:"attr_key_style"`
+	AttrKeyPattern string `mapstructure:"attr_key_pattern"`
+	// DangerousValues дополняет встроенный каталог типов и функций, значения которых нельзя логировать.
+	DangerousValues []DangerousValue `mapstructure:"dangerous_values"`
+	// InterpolationKinds включает и выключает правило interpolation для видов логгеров
+	// (slog, zap, zap-sugar, stdlog, kind из loggers); по умолчанию проверяются slog и zap.
+	InterpolationKinds map[string]bool `mapstructure:"interpolation_kinds"`
+	// StaticMessageKinds включает и выключает правило static_message для видов логгеров;
+	// по умолчанию проверяются все встроенные виды.
+	StaticMessageKinds map[string]bool `mapstructure:"static_message_kinds"`
+	// MessagePackages — пакеты каталога сообщений: значения из них считаются постоянными.
+	MessagePackages []string `mapstructure:"message_packages"`
+	// Taint — настройки taint-анализа (требует SSA).
+	Taint Taint `mapstructure:"taint"`
 }
 
 type Rules struct {
-	Lowercase      bool `mapstructure:"lowercase"`
-	English        bool `mapstructure:"english"`
-	EmojiOrSpesial bool `mapstructure:"emoji_or_special"`
-	Sensitive      bool `mapstructure:"sensitive"`
+	Lowercase       bool `mapstructure:"lowercase"`
+	English         bool `mapstructure:"english"`
+	EmojiOrSpesial  bool `mapstructure:"emoji_or_special"`
+	Sensitive       bool `mapstructure:"sensitive"`
+	AttrKeys        bool `mapstructure:"attr_keys"`
+	KeyValues       bool `mapstructure:"key_values"`
+	SecretFields    bool `mapstructure:"secret_fields"`
+	RedactionLeaks  bool `mapstructure:"redaction_leaks"`
+	Injection       bool `mapstructure:"injection"` // работает в режиме taint
+	DangerousValues bool `mapstructure:"dangerous_values"`
+	Interpolation   bool `mapstructure:"interpolation"`
+	StaticMessage   bool `mapstructure:"static_message"`
+	PrintfVerbs     bool `mapstructure:"printf_verbs"`
+	PrintfArgs      bool `mapstructure:"printf_args"`
+}
+
+// Logger описывает пользовательскую обёртку над логгером.
+// Если Receiver пуст, описываются функции пакета, иначе — методы типа Receiver (с указателем или без).
+type Logger struct {
+	Kind         string   `mapstructure:"kind"`
+	Package      string   `mapstructure:"package"`
+	Receiver     string   `mapstructure:"receiver"`
+	Methods      []string `mapstructure:"methods"`
+	MessageIndex int      `mapstructure:"message_index"`
+	Printf       bool     `mapstructure:"printf"`
+}
+
+// DangerousValue описывает тип Package.Type (значение или указатель) или функцию Package.Func
+// (метод, если задан и Type), значения которых раскрывают учётные данные при логировании.
+// Redact — метод типа, который возвращает безопасное представление (url.URL.Redacted);
+// исправление подставляет его вызов.
+type DangerousValue struct {
+	Package string `mapstructure:"package"`
+	Type    string `mapstructure:"type"`
+	Func    string `mapstructure:"func"`
+	Reason  string `mapstructure:"reason"`
+	Redact  string `mapstructure:"redact"`
+}
+
+// Taint описывает taint-анализ: значения из источников (запрос HTTP, окружение, флаги) не должны
+// доходить до аргументов логгеров и функций из Sinks, не пройдя через функцию из Sanitizers.
+// Источники, санитайзеры и стоки дополняют встроенный каталог анализатора.
+type Taint struct {
+	Enabled    bool        `mapstructure:"enabled"`
+	Sources    []TaintFunc `mapstructure:"sources"`
+	Sanitizers []TaintFunc `mapstructure:"sanitizers"`
+	Sinks      []TaintFunc `mapstructure:"sinks"`
+	// Escapers — функции экранирования для правила injection (в дополнение к встроенным
+	// strconv.Quote, url.QueryEscape и т.п.).
+	Escapers []TaintFunc `mapstructure:"escapers"`
+}
+
+// TaintFunc описывает функции пакета (Receiver пуст) или методы типа Receiver.
+type TaintFunc struct {
+	Package  string   `mapstructure:"package"`
+	Receiver string   `mapstructure:"receiver"`
+	Methods  []string `mapstructure:"methods"`
 }
 
 func Default() Config {
 	return Config{
 		Rules: Rules{
-			Lowercase:      true,
-			English:        true,
-			EmojiOrSpesial: true,
-			Sensitive:      true,
+			Lowercase:       true,
+			English:         true,
+			EmojiOrSpesial:  true,
+			Sensitive:       true,
+			KeyValues:       true,
+			SecretFields:    true,
+			RedactionLeaks:  true,
+			Injection:       true,
+			DangerousValues: true,
+			Interpolation:   true,
+			PrintfVerbs:     true,
+			PrintfArgs:      true,
 		},
 		SensitivePatterns: []string{
 			`(?i)\b(token|secret|api[_-]?key)\b\s*[:=]`,
@@ -26,5 +109,14 @@ func Default() Config {
 			// опционально: если встречается просто "Bearer <token>" без слова Authorization
 			`(?i)\bbearer\b\s+\S+`,
 		},
+		SensitiveKeys: []string{
+			"password", "passwd", "secret", "token", "api_key", "private_key",
+			"authorization", "credentials", "cookie",
+		},
+		AttrKeyStyle:       "snake_case",
+		InterpolationKinds: map[string]bool{"slog": true, "zap": true},
+		StaticMessageKinds: map[string]bool{
+			"slog": true, "zap": true, "zap-sugar": true, "logrus": true, "zerolog": true, "stdlog": true,
+		},
 	}
 }
@@ -12,4 +12,37 @@ func TestDefault(t *testing.T) {
 	if len(cfg.SensitivePatterns) == 0 {
 		t.Fatalf("default sensitive patterns must not be empty")
 	}
+
+	if len(cfg.SensitiveKeys) == 0 {
+		t.Fatalf("default sensitive keys must not be empty")
+	}
+
+	if !cfg.Rules.KeyValues || !cfg.Rules.SecretFields || !cfg.Rules.RedactionLeaks {
+		t.Fatalf("key_values, secret_fields and redaction_leaks rules must be enabled by default: %+v", cfg.Rules)
+	}
+
+	if !cfg.Rules.PrintfVerbs || !cfg.Rules.PrintfArgs {
+		t.Fatalf("printf_verbs and printf_args rules must be enabled by default")
+	}
+
+	if !cfg.Rules.DangerousValues || len(cfg.DangerousValues) != 0 {
+		t.Fatalf("dangerous_values rule must be enabled with the built-in catalog only: %+v", cfg)
+	}
+
+	k := cfg.InterpolationKinds
+	if !cfg.Rules.Interpolation || !k["slog"] || !k["zap"] || k["zap-sugar"] || k["stdlog"] {
+		t.Fatalf("interpolation rule must cover slog and zap only by default: %+v", k)
+	}
+
+	if cfg.Rules.StaticMessage || !cfg.StaticMessageKinds["slog"] || !cfg.StaticMessageKinds["stdlog"] {
+		t.Fatalf("static_message rule must be disabled and cover built-in kinds by default: %+v", cfg.StaticMessageKinds)
+	}
+
+	if cfg.Taint.Enabled {
+		t.Fatalf("taint analysis must be disabled by default")
+	}
+
+	if cfg.Rules.AttrKeys || cfg.AttrKeyStyle != "snake_case" {
+		t.Fatalf("attr_keys must be disabled with snake_case style by default: %+v", cfg)
+	}
 }
@@ -0,0 +1,217 @@
+// Package printf разбирает printf-шаблоны по правилам пакета fmt: флаги, ширина, точность,
+// '*' и явные индексы аргументов [n].
+package printf
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Directive — директива шаблона (%s, %-8.3f, %[2]*d).
+type Directive struct {
+	Pos, End int    // байтовый диапазон директивы в шаблоне, включая '%'
+	Text     string // исходный текст директивы
+	Flags    string // флаги из "#0+- " в порядке появления
+	Width    string // ширина: число, "*" или пусто
+	Prec     string // точность без точки: число, "*" или пусто
+	HasPrec  bool   // в директиве есть '.'
+	Verb     rune   // глагол; 0, если шаблон оборвался после '%'
+	// Arg — индекс операнда глагола (с нуля), WidthArg и PrecArg — операндов '*' (-1, если их нет).
+	Arg, WidthArg, PrecArg int
+	Indexed                bool   // использован явный индекс [n]
+	Err                    string // ошибка разбора: пустая, если директива корректна
+}
+
+// Segment — часть шаблона: литеральный текст или директива.
+type Segment struct {
+	Pos, End int    // байтовый диапазон части в шаблоне
+	Text     string // текст, который выведет часть: для "%%" — "%", для директивы — пусто
+	// Directive не nil, если часть — директива.
+	Directive *Directive
+}
+
+// knownVerbs — глаголы, которые понимает fmt.
+const knownVerbs = "bcdeEfFgGopqstTvxXUw"
+
+// KnownVerb сообщает, что r — глагол пакета fmt.
+func KnownVerb(r rune) bool {
+	return r != 0 && strings.ContainsRune(knownVerbs, r)
+}
+
+// Parse разбирает шаблон на литеральные части и директивы. "%%" выделяется в отдельную
+// литеральную часть с текстом "%", поэтому смещения внутри остальных литеральных частей
+// совпадают со смещениями в шаблоне.
+func Parse(format string) []Segment {
+	var segs []Segment
+	lit := 0
+	flush := func(end int) {
+		if end > lit {
+			segs = append(segs, Segment{Pos: lit, End: end, Text: format[lit:end]})
+		}
+	}
+
+	argNum := 0
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			i++
+			continue
+		}
+		flush(i)
+		d := parseDirective(format, i, &argNum)
+		if d.Verb == '%' && d.End-d.Pos == 2 {
+			segs = append(segs, Segment{Pos: d.Pos, End: d.End, Text: "%"})
+		} else {
+			segs = append(segs, Segment{Pos: d.Pos, End: d.End, Directive: &d})
+		}
+		i, lit = d.End, d.End
+	}
+	flush(len(format))
+	return segs
+}
+
+// Directives возвращает директивы шаблона без "%%".
+func Directives(format string) []Directive {
+	var out []Directive
+	for _, s := range Parse(format) {
+		if s.Directive != nil {
+			out = append(out, *s.Directive)
+		}
+	}
+	return out
+}
+
+// Literal возвращает текст шаблона, в котором каждая директива заменена на mark.
+func Literal(format, mark string) string {
+	var b strings.Builder
+	for _, s := range Parse(format) {
+		if s.Directive != nil {
+			b.WriteString(mark)
+			continue
+		}
+		b.WriteString(s.Text)
+	}
+	return b.String()
+}
+
+// Mask отмечает байты шаблона, которые не являются литеральным текстом: директивы и второй
+// '%' в "%%". Длина результата равна длине шаблона, поэтому смещения в нём совпадают со
+// смещениями в шаблоне.
+func Mask(format string) []bool {
+	mask := make([]bool, len(format))
+	for _, s := range Parse(format) {
+		from := s.Pos
+		if s.Directive == nil {
+			if s.End-s.Pos != 2 || s.Text != "%" {
+				continue
+			}
+			from++
+		}
+		for i := from; i < s.End; i++ {
+			mask[i] = true
+		}
+	}
+	return mask
+}
+
+// Prefix возвращает текст шаблона до первой директивы.
+func Prefix(format string) string {
+	var b strings.Builder
+	for _, s := range Parse(format) {
+		if s.Directive != nil {
+			break
+		}
+		b.WriteString(s.Text)
+	}
+	return b.String()
+}
+
+// ArgCount возвращает число операндов, которые использует шаблон.
+func ArgCount(dirs []Directive) int {
+	n := 0
+	for _, d := range dirs {
+		n = max(n, d.Arg+1, d.WidthArg+1, d.PrecArg+1)
+	}
+	return n
+}
+
+// parseDirective разбирает директиву, которая начинается с '%' в позиции pos. argNum — индекс
+// следующего операнда, он сдвигается так же, как в fmt.
+func parseDirective(format string, pos int, argNum *int) (d Directive) {
+	d = Directive{Pos: pos, Arg: -1, WidthArg: -1, PrecArg: -1}
+	i := pos + 1
+	defer func() {
+		d.End = i
+		d.Text = format[d.Pos:d.End]
+	}()
+
+	for i < len(format) && strings.IndexByte("#0+- ", format[i]) >= 0 {
+		d.Flags += format[i : i+1]
+		i++
+	}
+
+	index := func() {
+		if i >= len(format) || format[i] != '[' {
+			return
+		}
+		d.Indexed = true
+		end := strings.IndexByte(format[i:], ']')
+		if end < 0 {
+			d.Err = "unclosed argument index"
+			i = len(format)
+			return
+		}
+		n, err := strconv.Atoi(format[i+1 : i+end])
+		if err != nil || n < 1 {
+			d.Err = "bad argument index " + format[i:i+end+1]
+		} else {
+			*argNum = n - 1
+		}
+		i += end + 1
+	}
+	number := func() string {
+		start := i
+		for i < len(format) && '0' <= format[i] && format[i] <= '9' {
+			i++
+		}
+		return format[start:i]
+	}
+
+	index()
+	if i < len(format) && format[i] == '*' {
+		d.Width, d.WidthArg = "*", *argNum
+		*argNum++
+		i++
+	} else {
+		d.Width = number()
+	}
+
+	if i < len(format) && format[i] == '.' {
+		d.HasPrec = true
+		i++
+		index()
+		if i < len(format) && format[i] == '*' {
+			d.Prec, d.PrecArg = "*", *argNum
+			*argNum++
+			i++
+		} else {
+			d.Prec = number()
+		}
+	}
+	index()
+
+	if i >= len(format) {
+		if d.Err == "" {
+			d.Err = "missing verb"
+		}
+		return d
+	}
+	verb, size := utf8.DecodeRuneInString(format[i:])
+	i += size
+	d.Verb = verb
+	if verb != '%' {
+		d.Arg = *argNum
+		*argNum++
+	}
+	return d
+}
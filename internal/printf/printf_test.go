@@ -0,0 +1,135 @@
+package printf
+
+import "testing"
+
+func TestDirectives(t *testing.T) {
+	cases := []struct {
+		format string
+		want   []Directive
+	}{
+		{"user %s created", []Directive{
+			{Pos: 5, End: 7, Text: "%s", Verb: 's', Arg: 0, WidthArg: -1, PrecArg: -1},
+		}},
+		{"%-8.3f|%+v", []Directive{
+			{Pos: 0, End: 6, Text: "%-8.3f", Flags: "-", Width: "8", Prec: "3", HasPrec: true, Verb: 'f', Arg: 0, WidthArg: -1, PrecArg: -1},
+			{Pos: 7, End: 10, Text: "%+v", Flags: "+", Verb: 'v', Arg: 1, WidthArg: -1, PrecArg: -1},
+		}},
+		{"%*d %.*s", []Directive{
+			{Pos: 0, End: 3, Text: "%*d", Width: "*", Verb: 'd', Arg: 1, WidthArg: 0, PrecArg: -1},
+			{Pos: 4, End: 8, Text: "%.*s", Prec: "*", HasPrec: true, Verb: 's', Arg: 3, WidthArg: -1, PrecArg: 2},
+		}},
+		{"%[2]s %[1]q %s", []Directive{
+			{Pos: 0, End: 5, Text: "%[2]s", Verb: 's', Arg: 1, WidthArg: -1, PrecArg: -1, Indexed: true},
+			{Pos: 6, End: 11, Text: "%[1]q", Verb: 'q', Arg: 0, WidthArg: -1, PrecArg: -1, Indexed: true},
+			{Pos: 12, End: 14, Text: "%s", Verb: 's', Arg: 1, WidthArg: -1, PrecArg: -1},
+		}},
+		{"100%% done %", []Directive{
+			{Pos: 11, End: 12, Text: "%", Arg: -1, WidthArg: -1, PrecArg: -1, Err: "missing verb"},
+		}},
+		{"%[0]d", []Directive{
+			{Pos: 0, End: 5, Text: "%[0]d", Verb: 'd', Arg: 0, WidthArg: -1, PrecArg: -1, Indexed: true, Err: "bad argument index [0]"},
+		}},
+		{"привет %т", []Directive{
+			{Pos: 13, End: 16, Text: "%т", Verb: 'т', Arg: 0, WidthArg: -1, PrecArg: -1},
+		}},
+		{"no directives", nil},
+	}
+
+	for _, tc := range cases {
+		got := Directives(tc.format)
+		if len(got) != len(tc.want) {
+			t.Fatalf("Directives(%q) = %+v; want %+v", tc.format, got, tc.want)
+		}
+		for i := range got {
+			if got[i] != tc.want[i] {
+				t.Errorf("Directives(%q)[%d] = %+v; want %+v", tc.format, i, got[i], tc.want[i])
+			}
+		}
+	}
+}
+
+func TestParseSegments(t *testing.T) {
+	segs := Parse("load 100%% of %d items")
+	want := []struct {
+		pos, end  int
+		text      string
+		directive bool
+	}{
+		{0, 8, "load 100", false},
+		{8, 10, "%", false},
+		{10, 14, " of ", false},
+		{14, 16, "", true},
+		{16, 22, " items", false},
+	}
+	if len(segs) != len(want) {
+		t.Fatalf("Parse returned %d segments; want %d: %+v", len(segs), len(want), segs)
+	}
+	for i, w := range want {
+		s := segs[i]
+		if s.Pos != w.pos || s.End != w.end || s.Text != w.text || (s.Directive != nil) != w.directive {
+			t.Errorf("segment %d = %+v; want %+v", i, s, w)
+		}
+	}
+}
+
+func TestLiteralAndPrefix(t *testing.T) {
+	cases := []struct {
+		format, literal, prefix string
+	}{
+		{"user %s created in %dms", "user _ created in _ms", "user "},
+		{"100%% done", "100% done", "100% done"},
+		{"%[1]*.[2]*[3]f", "_", ""},
+		{"token=%", "token=_", "token="},
+	}
+	for _, tc := range cases {
+		if got := Literal(tc.format, "_"); got != tc.literal {
+			t.Errorf("Literal(%q) = %q; want %q", tc.format, got, tc.literal)
+		}
+		if got := Prefix(tc.format); got != tc.prefix {
+			t.Errorf("Prefix(%q) = %q; want %q", tc.format, got, tc.prefix)
+		}
+	}
+}
+
+func TestArgCount(t *testing.T) {
+	cases := []struct {
+		format string
+		want   int
+	}{
+		{"", 0},
+		{"%s %d", 2},
+		{"%[3]s", 3},
+		{"%*d", 2},
+		{"%[2]d %[1]d", 2},
+		{"100%%", 0},
+	}
+	for _, tc := range cases {
+		if got := ArgCount(Directives(tc.format)); got != tc.want {
+			t.Errorf("ArgCount(%q) = %d; want %d", tc.format, got, tc.want)
+		}
+	}
+}
+
+func TestMask(t *testing.T) {
+	cases := []struct {
+		format, want string
+	}{
+		{"user %s created", "user __ created"},
+		{"%#x and %[2]*d", "___ and ______"},
+		{"100%% done", "100%_ done"},
+		{"cost: %.2f€", "cost: ____€"},
+		{"tail %", "tail _"},
+		{"nul \x00 %d", "nul \x00 __"},
+	}
+	for _, tc := range cases {
+		got := []byte(tc.format)
+		for i, masked := range Mask(tc.format) {
+			if masked {
+				got[i] = '_'
+			}
+		}
+		if string(got) != tc.want {
+			t.Errorf("Mask(%q) = %q; want %q", tc.format, got, tc.want)
+		}
+	}
+}
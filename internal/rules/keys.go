@@ -0,0 +1,155 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const RAttrKeyStyle RuleID = "LOG005"
+
+// Поддерживаемые соглашения об именовании ключей атрибутов.
+const (
+	KeyStyleSnake = "snake_case"
+	KeyStyleCamel = "camelCase"
+	KeyStyleKebab = "kebab-case"
+	KeyStyleRegex = "regex"
+)
+
+// KeyStyle — соглашение об именовании ключей атрибутов.
+type KeyStyle struct {
+	Name string
+	Re   *regexp.Regexp
+}
+
+var keyStyleRes = map[string]*regexp.Regexp{
+	KeyStyleSnake: regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`),
+	KeyStyleCamel: regexp.MustCompile(`^[a-z][a-z0-9]*([A-Z][a-z0-9]*)*$`),
+	KeyStyleKebab: regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`),
+}
+
+// CompileKeyStyle строит KeyStyle по имени соглашения; для regex используется pattern.
+func CompileKeyStyle(name, pattern string) (KeyStyle, error) {
+	if name == KeyStyleRegex {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return KeyStyle{}, err
+		}
+		return KeyStyle{Name: name, Re: re}, nil
+	}
+	re, ok := keyStyleRes[name]
+	if !ok {
+		return KeyStyle{}, fmt.Errorf("unknown attribute key style %q", name)
+	}
+	return KeyStyle{Name: name, Re: re}, nil
+}
+
+// AttrKeyStyle проверяет ключ атрибута на соответствие соглашению. Для встроенных соглашений
+// ключ может состоять из нескольких сегментов через точку (http.status_code).
+func AttrKeyStyle(key string, style KeyStyle) (Violation, bool) {
+	if style.Re == nil || key == "" {
+		return Violation{}, false
+	}
+
+	segments := []string{key}
+	if style.Name != KeyStyleRegex {
+		segments = strings.Split(key, ".")
+	}
+	for _, s := range segments {
+		if !style.Re.MatchString(s) {
+			return Violation{ID: RAttrKeyStyle, Message: fmt.Sprintf("attribute key %q does not match %s convention", key, style.Name)}, true
+		}
+	}
+	return Violation{}, false
+}
+
+// ConvertKey переписывает ключ в соглашение style. Для regex преобразование неизвестно.
+func ConvertKey(key string, style string) (string, bool) {
+	if style == KeyStyleRegex {
+		return "", false
+	}
+
+	segments := strings.Split(key, ".")
+	for i, s := range segments {
+		words := SplitKeyWords(s)
+		if len(words) == 0 {
+			return "", false
+		}
+		switch style {
+		case KeyStyleSnake:
+			segments[i] = strings.Join(words, "_")
+		case KeyStyleKebab:
+			segments[i] = strings.Join(words, "-")
+		case KeyStyleCamel:
+			for j := 1; j < len(words); j++ {
+				r := []rune(words[j])
+				r[0] = unicode.ToUpper(r[0])
+				words[j] = string(r)
+			}
+			segments[i] = strings.Join(words, "")
+		default:
+			return "", false
+		}
+	}
+	return strings.Join(segments, "."), true
+}
+
+// SplitKeyWords разбивает ключ на слова в нижнем регистре: по '_', '-', '.', пробелам и
+// границам camelCase, сохраняя аббревиатуры целиком (UserID -> user, id; XApiKey -> x, api, key).
+func SplitKeyWords(key string) []string {
+	var (
+		words []string
+		cur   []rune
+	)
+	flush := func() {
+		if len(cur) > 0 {
+			words = append(words, strings.ToLower(string(cur)))
+			cur = cur[:0]
+		}
+	}
+
+	rs := []rune(key)
+	for i, r := range rs {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(cur) > 0 {
+			prev := rs[i-1]
+			nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				flush()
+			}
+		}
+		cur = append(cur, r)
+	}
+	flush()
+	return words
+}
+
+// SensitiveKey проверяет ключ атрибута по списку запрещённых слов. Ключ и записи списка
+// разбиваются на слова (SplitKeyWords); запись совпадает, если подряд идущие слова ключа
+// образуют её слова, поэтому api_key совпадает с apiKey, APIKey, X-Api-Key и apikey.
+func SensitiveKey(key string, denylist []string) (Violation, bool) {
+	words := SplitKeyWords(key)
+	for _, entry := range denylist {
+		want := strings.Join(SplitKeyWords(entry), "")
+		if want == "" {
+			continue
+		}
+		for i := range words {
+			joined := ""
+			for _, w := range words[i:] {
+				joined += w
+				if len(joined) >= len(want) {
+					break
+				}
+			}
+			if joined == want {
+				return Violation{ID: RSensitive, Message: fmt.Sprintf("attribute key %q looks sensitive", key)}, true
+			}
+		}
+	}
+	return Violation{}, false
+}
@@ -0,0 +1,123 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitKeyWords(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"user_id", []string{"user", "id"}},
+		{"userId", []string{"user", "id"}},
+		{"UserID", []string{"user", "id"}},
+		{"X-Api-Key", []string{"x", "api", "key"}},
+		{"HTTPStatus", []string{"http", "status"}},
+		{"retry2Count", []string{"retry2", "count"}},
+		{"", nil},
+	}
+
+	for _, tc := range cases {
+		got := SplitKeyWords(tc.in)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("SplitKeyWords(%q) = %v; want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestAttrKeyStyle(t *testing.T) {
+	snake, err := CompileKeyStyle(KeyStyleSnake, "")
+	if err != nil {
+		t.Fatalf("CompileKeyStyle returned error: %v", err)
+	}
+	camel, _ := CompileKeyStyle(KeyStyleCamel, "")
+	kebab, _ := CompileKeyStyle(KeyStyleKebab, "")
+	custom, err := CompileKeyStyle(KeyStyleRegex, `^[a-z]+$`)
+	if err != nil {
+		t.Fatalf("CompileKeyStyle returned error: %v", err)
+	}
+
+	cases := []struct {
+		key   string
+		style KeyStyle
+		want  bool
+	}{
+		{"user_id", snake, false},
+		{"http.status_code", snake, false},
+		{"UserID", snake, true},
+		{"user-id", snake, true},
+		{"userId", camel, false},
+		{"user_id", camel, true},
+		{"user-id", kebab, false},
+		{"userId", kebab, true},
+		{"user", custom, false},
+		{"user.id", custom, true},
+	}
+	for _, tc := range cases {
+		_, got := AttrKeyStyle(tc.key, tc.style)
+		if got != tc.want {
+			t.Errorf("AttrKeyStyle(%q, %s) = %v; want %v", tc.key, tc.style.Name, got, tc.want)
+		}
+	}
+}
+
+func TestCompileKeyStyle_Invalid(t *testing.T) {
+	if _, err := CompileKeyStyle("PascalCase", ""); err == nil {
+		t.Fatalf("expected error for unknown style, got nil")
+	}
+	if _, err := CompileKeyStyle(KeyStyleRegex, "("); err == nil {
+		t.Fatalf("expected error for invalid regexp, got nil")
+	}
+}
+
+func TestConvertKey(t *testing.T) {
+	cases := []struct {
+		key, style, want string
+	}{
+		{"UserID", KeyStyleSnake, "user_id"},
+		{"user-id", KeyStyleCamel, "userId"},
+		{"userId", KeyStyleKebab, "user-id"},
+		{"http.StatusCode", KeyStyleSnake, "http.status_code"},
+	}
+	for _, tc := range cases {
+		got, ok := ConvertKey(tc.key, tc.style)
+		if !ok || got != tc.want {
+			t.Errorf("ConvertKey(%q, %s) = %q, %v; want %q", tc.key, tc.style, got, ok, tc.want)
+		}
+	}
+
+	if _, ok := ConvertKey("UserID", KeyStyleRegex); ok {
+		t.Fatalf("ConvertKey must not convert keys for regex style")
+	}
+}
+
+func TestSensitiveKey(t *testing.T) {
+	denylist := []string{"password", "api_key", "token"}
+
+	cases := []struct {
+		key  string
+		want bool
+	}{
+		{"password", true},
+		{"userPassword", true},
+		{"apiKey", true},
+		{"api_key", true},
+		{"X-Api-Key", true},
+		{"APIKey", true},
+		{"apikey", true},
+		{"access_token", true},
+		{"api", false},
+		{"keyboard", false},
+		{"tokens", false},
+		{"user_id", false},
+	}
+
+	for _, tc := range cases {
+		_, got := SensitiveKey(tc.key, denylist)
+		if got != tc.want {
+			t.Errorf("SensitiveKey(%q) = %v; want %v", tc.key, got, tc.want)
+		}
+	}
+}
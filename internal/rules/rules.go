@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/iconfire7/loglintergo/internal/config"
 )
@@ -16,15 +17,37 @@ const (
 	REnglishOnly    RuleID = "LOG002"
 	RNoEmojiSpecial RuleID = "LOG003"
 	RSensitive      RuleID = "LOG004"
+	RKeyValues      RuleID = "LOG006"
+	RSecretFields   RuleID = "LOG007"
+	RRedactionLeak  RuleID = "LOG008"
+	RTaint          RuleID = "LOG009"
+	RInjection      RuleID = "LOG010"
+	RDangerousValue RuleID = "LOG011"
+	RInterpolation  RuleID = "LOG012"
+	RStaticMessage  RuleID = "LOG013"
+	RPrintfVerbs    RuleID = "LOG014"
+	RPrintfArgs     RuleID = "LOG015"
 )
 
 type Violation struct {
 	ID      RuleID
 	Message string
+	// Start, End — байтовый диапазон нарушения в сообщении; End == 0, если нарушение
+	// относится ко всему сообщению.
+	Start, End int
+}
+
+// Mask отмечает байты сообщения, которые не являются его текстом, — директивы
+// printf-шаблона (см. printf.Mask). Правила их пропускают; nil — маскированных байтов нет.
+type Mask []bool
+
+// has сообщает, что байт i замаскирован.
+func (m Mask) has(i int) bool {
+	return i < len(m) && m[i]
 }
 
 // CheckAll проверяет все правила
-func CheckAll(msg string, rulesConfig config.Rules, sensitive []*regexp.Regexp) []Violation {
+func CheckAll(msg string, mask Mask, rulesConfig config.Rules, sensitive []*regexp.Regexp) []Violation {
 	var out []Violation
 	if rulesConfig.Lowercase {
 		if v, ok := LowercaseStart(msg); ok {
@@ -32,12 +55,12 @@ func CheckAll(msg string, rulesConfig config.Rules, sensitive []*regexp.Regexp)
 		}
 	}
 	if rulesConfig.English {
-		if v, ok := EnglishOnly(msg); ok {
+		if v, ok := EnglishOnly(msg, mask); ok {
 			out = append(out, v)
 		}
 	}
 	if rulesConfig.EmojiOrSpesial {
-		if v, ok := NoEmojiOrSpecials(msg); ok {
+		if v, ok := NoEmojiOrSpecials(msg, mask); ok {
 			out = append(out, v)
 		}
 	}
@@ -58,26 +81,48 @@ func LowercaseStart(msg string) (Violation, bool) {
 
 	r, _ := getFirstRune(s)
 	if unicode.IsLetter(r) && unicode.IsUpper(r) {
-		return Violation{ID: RLowercaseStart, Message: "log message must not start with an uppercase letter"}, true
+		start := len(msg) - len(s)
+		return Violation{
+			ID:      RLowercaseStart,
+			Message: "log message must not start with an uppercase letter",
+			Start:   start,
+			End:     runeEnd(msg, start),
+		}, true
 	}
 	return Violation{}, false
 }
 
 // EnglishOnly проверяет на английский язык
-func EnglishOnly(msg string) (Violation, bool) {
-	for _, r := range msg {
+func EnglishOnly(msg string, mask Mask) (Violation, bool) {
+	for i, r := range msg {
+		if mask.has(i) {
+			continue
+		}
 		if unicode.IsLetter(r) && !unicode.In(r, unicode.Latin) {
-			return Violation{ID: REnglishOnly, Message: "log message must be in English (Latin letters only)"}, true
+			return Violation{
+				ID:      REnglishOnly,
+				Message: "log message must be in English (Latin letters only)",
+				Start:   i,
+				End:     runeEnd(msg, i),
+			}, true
 		}
 	}
 	return Violation{}, false
 }
 
 // NoEmojiOrSpecials проверяет на спецсимволы и эмодзи
-func NoEmojiOrSpecials(msg string) (Violation, bool) {
-	for _, r := range msg {
+func NoEmojiOrSpecials(msg string, mask Mask) (Violation, bool) {
+	for i, r := range msg {
+		if mask.has(i) {
+			continue
+		}
 		if !IsAllowedLogChar(r) {
-			return Violation{ID: RNoEmojiSpecial, Message: fmt.Sprintf("log message must not contain emoji or special characters (bad rune %U, %q)", r, string(r))}, true
+			return Violation{
+				ID:      RNoEmojiSpecial,
+				Message: fmt.Sprintf("log message must not contain emoji or special characters (bad rune %U, %q)", r, string(r)),
+				Start:   i,
+				End:     runeEnd(msg, i),
+			}, true
 		}
 	}
 	return Violation{}, false
@@ -89,13 +134,19 @@ func NoSensitivePatterns(msg string, patterns []*regexp.Regexp) (Violation, bool
 		return Violation{}, false
 	}
 	for _, re := range patterns {
-		if re.MatchString(msg) {
-			return Violation{ID: RSensitive, Message: "log message matches sensitive pattern"}, true
+		if m := re.FindStringIndex(msg); m != nil {
+			return Violation{ID: RSensitive, Message: "log message matches sensitive pattern", Start: m[0], End: m[1]}, true
 		}
 	}
 	return Violation{}, false
 }
 
+// runeEnd возвращает конец руны, которая начинается в msg[i].
+func runeEnd(msg string, i int) int {
+	_, size := utf8.DecodeRuneInString(msg[i:])
+	return i + size
+}
+
 // getFirstRune Маленький хелпер чтобы не тащить utf8 в каждый файл
 func getFirstRune(s string) (rune, int) {
 	for i, r := range s {
@@ -39,7 +39,7 @@ func TestEnglishOnly(t *testing.T) {
 	}
 
 	for _, tc := range cases {
-		_, got := EnglishOnly(tc.in)
+		_, got := EnglishOnly(tc.in, nil)
 		if got != tc.want {
 			t.Errorf("EnglishOnly(%q) = %v; want %v", tc.in, got, tc.want)
 		}
@@ -57,7 +57,7 @@ func TestNoEmojiOrSpecials(t *testing.T) {
 		{"Привет", true},
 	}
 	for _, tc := range cases {
-		_, got := NoEmojiOrSpecials(tc.in)
+		_, got := NoEmojiOrSpecials(tc.in, nil)
 		if got != tc.want {
 			t.Fatalf("NoEmojiOrSpecials(%q)=%v want %v", tc.in, got, tc.want)
 		}
@@ -95,7 +95,7 @@ func TestCheckAll(t *testing.T) {
 	}
 
 	sensitive := []*regexp.Regexp{regexp.MustCompile(`(?i)token`)}
-	violations := CheckAll("User token🙂", rulesConfig, sensitive)
+	violations := CheckAll("User token🙂", nil, rulesConfig, sensitive)
 	if len(violations) != 3 {
 		t.Fatalf("CheckAll returned %d violations; want 3", len(violations))
 	}
@@ -145,3 +145,44 @@ func TestIsAllowedLogChar(t *testing.T) {
 		}
 	}
 }
+
+func TestViolationRange(t *testing.T) {
+	cases := []struct {
+		name       string
+		check      func(string) (Violation, bool)
+		in         string
+		start, end int
+	}{
+		{"lowercase", LowercaseStart, "  User created", 2, 3},
+		{"english", func(s string) (Violation, bool) { return EnglishOnly(s, nil) }, "user создан", 5, 7},
+		{"emoji", func(s string) (Violation, bool) { return NoEmojiOrSpecials(s, nil) }, "done 🚀!", 5, 9},
+		{"masked directive", func(s string) (Violation, bool) {
+			return NoEmojiOrSpecials(s, Mask{6: true, 7: true, 8: true})
+		}, "value %+v done!", 14, 15},
+		{"nul", func(s string) (Violation, bool) { return NoEmojiOrSpecials(s, nil) }, "value \x00 done", 6, 7},
+		{"sensitive", func(s string) (Violation, bool) {
+			return NoSensitivePatterns(s, []*regexp.Regexp{regexp.MustCompile(`(?i)\btoken\s*=`)})
+		}, "user Token = abc", 5, 12},
+	}
+	for _, tc := range cases {
+		v, ok := tc.check(tc.in)
+		if !ok || v.Start != tc.start || v.End != tc.end {
+			t.Errorf("%s(%q) = %+v, %v; want range [%d, %d)", tc.name, tc.in, v, ok, tc.start, tc.end)
+		}
+	}
+}
+
+func TestMaskedDirectives(t *testing.T) {
+	// "%Ж" — директива с неизвестным глаголом: она не является текстом сообщения.
+	msg := "bad %Ж verb"
+	mask := Mask{4: true, 5: true, 6: true}
+	if v, ok := EnglishOnly(msg, mask); ok {
+		t.Errorf("EnglishOnly(%q) = %+v; want no violation", msg, v)
+	}
+	if v, ok := NoEmojiOrSpecials(msg, mask); ok {
+		t.Errorf("NoEmojiOrSpecials(%q) = %+v; want no violation", msg, v)
+	}
+	if _, ok := EnglishOnly(msg, nil); !ok {
+		t.Errorf("EnglishOnly(%q) without mask: want violation", msg)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/iconfire7/loglintergo/internal/analyzer/loglinter"
 	"github.com/iconfire7/loglintergo/internal/config"
+	"github.com/iconfire7/loglintergo/internal/rules"
 )
 
 func init() {
@@ -53,6 +54,43 @@ func New(settings any) (register.LinterPlugin, error) {
 		return nil, fmt.Errorf("decode settings: %w", err)
 	}
 
+	for i, l := range cfg.Loggers {
+		if l.Package == "" {
+			return nil, fmt.Errorf("loggers[%d]: package is required", i)
+		}
+		if len(l.Methods) == 0 {
+			return nil, fmt.Errorf("loggers[%d] (%s): methods are required", i, l.Package)
+		}
+		if l.MessageIndex < 0 {
+			return nil, fmt.Errorf("loggers[%d] (%s): message_index must not be negative", i, l.Package)
+		}
+	}
+
+	for name, list := range map[string][]config.TaintFunc{
+		"taint.sources":    cfg.Taint.Sources,
+		"taint.sanitizers": cfg.Taint.Sanitizers,
+		"taint.sinks":      cfg.Taint.Sinks,
+		"taint.escapers":   cfg.Taint.Escapers,
+	} {
+		for i, f := range list {
+			if f.Package == "" || len(f.Methods) == 0 {
+				return nil, fmt.Errorf("%s[%d]: package and methods are required", name, i)
+			}
+		}
+	}
+
+	for i, v := range cfg.DangerousValues {
+		if v.Package == "" || (v.Type == "" && v.Func == "") {
+			return nil, fmt.Errorf("dangerous_values[%d]: package and type or func are required", i)
+		}
+	}
+
+	if cfg.Rules.AttrKeys {
+		if _, err := rules.CompileKeyStyle(cfg.AttrKeyStyle, cfg.AttrKeyPattern); err != nil {
+			return nil, fmt.Errorf("invalid attr_key_style: %w", err)
+		}
+	}
+
 	var reg []*regexp.Regexp
 	if cfg.Rules.Sensitive && len(cfg.SensitivePatterns) > 0 {
 		reg = make([]*regexp.Regexp, 0, len(cfg.SensitivePatterns))
@@ -1,8 +1,11 @@
 package a
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"log/slog"
+	"net/url"
 )
 
 func demo(tk, secret, bearer string) {
@@ -25,4 +28,19 @@ func demo(tk, secret, bearer string) {
 	slog.Info("token=")
 
 	slog.Info(fmt.Sprintf("token=%s"))
+
+	ctx := context.Background()
+
+	slog.InfoContext(ctx, "Request handled")
+
+	slog.Log(ctx, slog.LevelWarn, "token="+tk)
+
+	log.Printf("Connected with token=%s", tk)
+
+	slog.Info("user logged in", "password", tk)
+
+	slog.Info("request handled", "status", 200, "path")
+
+	u, _ := url.Parse("https://admin:" + secret + "@db.local")
+	slog.Info("connecting", "url", u)
 }
@@ -9,6 +9,8 @@ type config struct {
 	user string
 	host string
 	port string
+
+	dbPassword string
 }
 
 func main() {